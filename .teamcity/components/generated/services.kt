@@ -6,6 +6,7 @@ var services = mapOf(
         "administrativeunits" to "Administrative Units",
         "approleassignments" to "App Role Assignments",
         "applications" to "Applications",
+        "auditlogs" to "Audit Logs",
         "conditionalaccess" to "Conditional Access",
         "directoryobjects" to "Directory Objects",
         "directoryroles" to "Directory Roles",
@@ -22,6 +22,7 @@ import (
 	administrativeunits "github.com/hashicorp/terraform-provider-azuread/internal/services/administrativeunits/client"
 	applications "github.com/hashicorp/terraform-provider-azuread/internal/services/applications/client"
 	approleassignments "github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/client"
+	auditlogs "github.com/hashicorp/terraform-provider-azuread/internal/services/auditlogs/client"
 	conditionalaccess "github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess/client"
 	directoryobjects "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryobjects/client"
 	directoryroles "github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles/client"
@@ -51,6 +52,7 @@ type Client struct {
 	AdministrativeUnits *administrativeunits.Client
 	Applications        *applications.Client
 	AppRoleAssignments  *approleassignments.Client
+	AuditLogs           *auditlogs.Client
 	ConditionalAccess   *conditionalaccess.Client
 	DirectoryObjects    *directoryobjects.Client
 	DirectoryRoles      *directoryroles.Client
@@ -79,6 +81,9 @@ func (client *Client) build(ctx context.Context, o *common.ClientOptions) error
 	if client.AppRoleAssignments, err = approleassignments.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for AppRoleAssignments: %v", err)
 	}
+	if client.AuditLogs, err = auditlogs.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for AuditLogs: %v", err)
+	}
 	if client.ConditionalAccess, err = conditionalaccess.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for ConditionalAccess: %v", err)
 	}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+func TestFeatureTags(t *testing.T) {
+	cases := []struct {
+		TestName string
+		Features map[string]interface{}
+		Tags     []string
+	}{
+		{
+			TestName: "None",
+			Features: map[string]interface{}{
+				"custom_single_sign_on": false,
+				"enterprise":            false,
+				"gallery":               false,
+				"hide":                  false,
+			},
+			Tags: []string{},
+		},
+		{
+			TestName: "All",
+			Features: map[string]interface{}{
+				"custom_single_sign_on": true,
+				"enterprise":            true,
+				"gallery":               true,
+				"hide":                  true,
+			},
+			Tags: []string{
+				"WindowsAzureActiveDirectoryCustomSingleSignOnApplication",
+				"WindowsAzureActiveDirectoryIntegratedApp",
+				"WindowsAzureActiveDirectoryGalleryApplicationNonPrimaryV1",
+				"HideApp",
+			},
+		},
+		{
+			TestName: "EnterpriseHidden",
+			Features: map[string]interface{}{
+				"custom_single_sign_on": false,
+				"enterprise":            true,
+				"gallery":               false,
+				"hide":                  true,
+			},
+			Tags: []string{
+				"WindowsAzureActiveDirectoryIntegratedApp",
+				"HideApp",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			tags := ExpandFeatures([]interface{}{tc.Features})
+			if !reflect.DeepEqual(tags, tc.Tags) {
+				t.Fatalf("expected tags %v, got %v", tc.Tags, tags)
+			}
+
+			features := FlattenFeatures(&tags, false)
+			if len(features) != 1 {
+				t.Fatalf("expected 1 feature_tags block, got %d", len(features))
+			}
+			for k, v := range tc.Features {
+				if features[0].(map[string]bool)[k] != v.(bool) {
+					t.Fatalf("expected feature %q to be %t after flattening %v", k, v, tags)
+				}
+			}
+		})
+	}
+}
+
+func TestFlattenFeaturesIgnoresCaseAndUnknownTags(t *testing.T) {
+	tags := []string{"hideapp", "CustomTag", "windowsazureactivedirectoryintegratedapp"}
+
+	features := FlattenFeatures(&tags, false)[0].(map[string]bool)
+
+	expected := map[string]bool{
+		"custom_single_sign_on": false,
+		"enterprise":            true,
+		"gallery":               false,
+		"hide":                  true,
+	}
+	if !reflect.DeepEqual(features, expected) {
+		t.Fatalf("expected %v, got %v", expected, features)
+	}
+}
+
+func TestFlattenAppRoleIDs(t *testing.T) {
+	roles := []stable.AppRole{
+		{Id: pointer.To("00000000-0000-0000-0000-000000000001"), Value: nullable.Value("User.Read.All")},
+		{Id: pointer.To("00000000-0000-0000-0000-000000000002"), Value: nullable.Value("")},
+		{Id: nil, Value: nullable.Value("Orphan.Role")},
+	}
+
+	expected := map[string]string{
+		"User.Read.All": "00000000-0000-0000-0000-000000000001",
+	}
+	if result := FlattenAppRoleIDs(&roles); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, result)
+	}
+
+	if result := FlattenAppRoleIDs(nil); result == nil || len(result) != 0 {
+		t.Fatalf("Expected empty map for nil roles, got %#v", result)
+	}
+}
+
+func TestFlattenOAuth2PermissionScopeIDs(t *testing.T) {
+	scopes := []stable.PermissionScope{
+		{Id: pointer.To("00000000-0000-0000-0000-000000000001"), Value: nullable.Value("User.Read")},
+		{Id: pointer.To("00000000-0000-0000-0000-000000000002"), Value: nullable.NoZero("")},
+	}
+
+	expected := map[string]string{
+		"User.Read": "00000000-0000-0000-0000-000000000001",
+	}
+	if result := FlattenOAuth2PermissionScopeIDs(&scopes); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, result)
+	}
+
+	if result := FlattenOAuth2PermissionScopeIDs(nil); result == nil || len(result) != 0 {
+		t.Fatalf("Expected empty map for nil scopes, got %#v", result)
+	}
+}
@@ -5,11 +5,13 @@ package credentials
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/sha1"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"software.sslmate.com/src/go-pkcs12"
 )
 
 func GetKeyCredential(keyCredentials *[]stable.KeyCredential, id string) (credential *stable.KeyCredential) {
@@ -56,6 +59,39 @@ func GetPasswordCredential(passwordCredentials *[]stable.PasswordCredential, id
 	return
 }
 
+// GetKeyCredentialThumbprint returns the hex encoded SHA-1 thumbprint of a certificate credential, which Azure Active
+// Directory exposes as the base64 encoded custom key identifier. An empty string is returned if the custom key
+// identifier is not set or cannot be decoded.
+func GetKeyCredentialThumbprint(credential stable.KeyCredential) string {
+	thumbprint, err := base64.StdEncoding.DecodeString(credential.CustomKeyIdentifier.GetOrZero())
+	if err != nil {
+		return ""
+	}
+	return strings.ToUpper(hex.EncodeToString(thumbprint))
+}
+
+// GetVerifyKeyCredentialIdFromThumbprint returns the key ID of the certificate credential with Verify usage having the
+// specified hex encoded SHA-1 thumbprint. An error is returned if there is not exactly one such credential.
+func GetVerifyKeyCredentialIdFromThumbprint(keyCredentials *[]stable.KeyCredential, thumbprint string) (string, error) {
+	keyIds := make([]string, 0)
+	if keyCredentials != nil {
+		for _, cred := range *keyCredentials {
+			if !cred.KeyId.IsNull() && strings.EqualFold(GetKeyCredentialThumbprint(cred), thumbprint) && strings.EqualFold(cred.Usage.GetOrZero(), KeyCredentialUsageVerify) {
+				keyIds = append(keyIds, cred.KeyId.GetOrZero())
+			}
+		}
+	}
+
+	switch len(keyIds) {
+	case 0:
+		return "", fmt.Errorf("no certificate credential was found with thumbprint %s", strings.ToUpper(thumbprint))
+	case 1:
+		return keyIds[0], nil
+	}
+
+	return "", fmt.Errorf("more than one certificate credential was found with thumbprint %s, please import using the key ID instead: %s", strings.ToUpper(thumbprint), strings.Join(keyIds, ", "))
+}
+
 func GetTokenSigningCertificateThumbprint(certByte []byte) (string, error) {
 	block, _ := pem.Decode(certByte)
 	if block == nil {
@@ -74,34 +110,171 @@ func GetTokenSigningCertificateThumbprint(certByte []byte) (string, error) {
 	return buf.String(), nil
 }
 
-func KeyCredentialForResource(d *pluginsdk.ResourceData) (*stable.KeyCredential, error) {
-	keyType := d.Get("type").(string)
-	value := d.Get("value").(string)
+// DecodePkcs12Certificate decrypts a DER encoded PKCS#12 bundle and returns the leaf certificate, which is identified
+// as the single certificate in the bundle whose public key matches the bundled private key.
+func DecodePkcs12Certificate(pfx []byte, password string) (*x509.Certificate, error) {
+	privateKey, cert, caCerts, err := pkcs12.DecodeChain(pfx, password)
+	if err != nil {
+		return nil, err
+	}
 
-	var encodedValue string
-	encoding := d.Get("encoding").(string)
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key type in bundle")
+	}
+	publicKey, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return nil, errors.New("unsupported public key type in bundle")
+	}
+
+	var leaf *x509.Certificate
+	for _, c := range append([]*x509.Certificate{cert}, caCerts...) {
+		if publicKey.Equal(c.PublicKey) {
+			if leaf != nil {
+				return nil, errors.New("bundle contains multiple certificates matching the private key, unable to determine which certificate to use")
+			}
+			leaf = c
+		}
+	}
+	if leaf == nil {
+		return nil, errors.New("bundle does not contain a certificate matching the private key")
+	}
+
+	return leaf, nil
+}
+
+// decodePemCertificate decodes PEM encoded certificate data, which may contain a single certificate or a certificate
+// chain, and returns the leaf certificate.
+func decodePemCertificate(value string) (*x509.Certificate, error) {
+	certs := make([]*x509.Certificate, 0)
+
+	rest := []byte(value)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return nil, CredentialError{str: fmt.Sprintf("PEM certificate data contains a %q block, expected only \"CERTIFICATE\" blocks", block.Type), attr: "value"}
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("failed to parse pem encoded certificate data: %+v", err), attr: "value"}
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, CredentialError{str: "failed to decode PEM certificate data, ensure the value contains a complete `-----BEGIN CERTIFICATE-----` block", attr: "value"}
+	}
+
+	leaf, err := leafCertificate(certs)
+	if err != nil {
+		return nil, CredentialError{str: fmt.Sprintf("PEM certificate data contains a certificate chain: %+v", err), attr: "value"}
+	}
+
+	return leaf, nil
+}
+
+// leafCertificate returns the single certificate in the provided chain which has not issued any of the other
+// certificates in the chain.
+func leafCertificate(certs []*x509.Certificate) (*x509.Certificate, error) {
+	if len(certs) == 1 {
+		return certs[0], nil
+	}
+
+	leaves := make([]*x509.Certificate, 0)
+	for i, candidate := range certs {
+		issuer := false
+		for j, cert := range certs {
+			if i == j {
+				continue
+			}
+			if bytes.Equal(cert.RawIssuer, candidate.RawSubject) && cert.CheckSignatureFrom(candidate) == nil {
+				issuer = true
+				break
+			}
+		}
+		if !issuer {
+			leaves = append(leaves, candidate)
+		}
+	}
+
+	switch len(leaves) {
+	case 0:
+		return nil, errors.New("unable to identify the leaf certificate, as every certificate has issued another certificate in the chain")
+	case 1:
+		return leaves[0], nil
+	}
+
+	subjects := make([]string, 0, len(leaves))
+	for _, leaf := range leaves {
+		subjects = append(subjects, fmt.Sprintf("%q", leaf.Subject.String()))
+	}
+	return nil, fmt.Errorf("unable to identify the leaf certificate, found multiple candidates: %s", strings.Join(subjects, ", "))
+}
+
+func KeyCredential(in map[string]interface{}) (*stable.KeyCredential, error) {
+	keyType, _ := in["type"].(string)
+	value, _ := in["value"].(string)
+
+	// Symmetric keys are not certificates, so the value is not validated as such
+	validate := !strings.EqualFold(keyType, "Symmetric")
+
+	var der []byte
+	encoding, _ := in["encoding"].(string)
 	switch encoding {
 	case "base64":
-		der, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		var err error
+		der, err = base64.StdEncoding.DecodeString(strings.TrimSpace(value))
 		if err != nil {
-			return nil, fmt.Errorf("failed to decode base64 certificate data")
+			return nil, CredentialError{str: fmt.Sprintf("failed to decode base64 certificate data: %+v", err), attr: "value"}
 		}
-		block := pem.Block{
-			Type:  "CERTIFICATE",
-			Bytes: der,
+	case "hex":
+		var err error
+		der, err = hex.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("failed to decode hexadecimal certificate data: %+v", err), attr: "value"}
 		}
-		pemVal := pem.EncodeToMemory(&block)
-		if pemVal == nil {
-			return nil, fmt.Errorf("failed to PEM-encode certificate")
+	case "pem":
+		if validate {
+			cert, err := decodePemCertificate(value)
+			if err != nil {
+				return nil, err
+			}
+			der = cert.Raw
 		}
-		encodedValue = base64.StdEncoding.EncodeToString(pemVal)
-	case "hex":
-		bytesVal := []byte(strings.TrimSpace(value))
-		der := make([]byte, hex.DecodedLen(len(bytesVal)))
-		_, err := hex.Decode(der, bytesVal)
+	case "pfx":
+		pfx, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
 		if err != nil {
-			return nil, fmt.Errorf("failed to decode hexadecimal certificate data: %+v", err)
+			return nil, CredentialError{str: "failed to decode base64 PKCS#12 certificate bundle", attr: "value"}
+		}
+		password, _ := in["password"].(string)
+		cert, err := DecodePkcs12Certificate(pfx, password)
+		if err != nil {
+			attr := "value"
+			if errors.Is(err, pkcs12.ErrIncorrectPassword) {
+				attr = "password"
+			}
+			return nil, CredentialError{str: fmt.Sprintf("failed to decode PKCS#12 certificate bundle: %+v", err), attr: attr}
+		}
+		der = cert.Raw
+	}
+
+	if validate && der != nil {
+		if _, err := x509.ParseCertificate(der); err != nil {
+			return nil, CredentialError{str: fmt.Sprintf("failed to parse %s encoded certificate data: %+v", encoding, err), attr: "value"}
 		}
+	}
+
+	// When a PEM value is not validated, it is uploaded as-is. Otherwise, the decoded certificate is re-encoded so that
+	// only the leaf certificate is uploaded when the value contains a certificate chain.
+	var encodedValue string
+	if encoding == "pem" && der == nil {
+		encodedValue = base64.StdEncoding.EncodeToString([]byte(value))
+	} else if der != nil {
 		block := pem.Block{
 			Type:  "CERTIFICATE",
 			Bytes: der,
@@ -111,12 +284,10 @@ func KeyCredentialForResource(d *pluginsdk.ResourceData) (*stable.KeyCredential,
 			return nil, fmt.Errorf("failed to PEM-encode certificate")
 		}
 		encodedValue = base64.StdEncoding.EncodeToString(pemVal)
-	case "pem":
-		encodedValue = base64.StdEncoding.EncodeToString([]byte(value))
 	}
 
 	var keyId string
-	if v, ok := d.GetOk("key_id"); ok {
+	if v, ok := in["key_id"]; ok && v.(string) != "" {
 		keyId = v.(string)
 	} else {
 		kid, err := uuid.GenerateUUID()
@@ -127,53 +298,77 @@ func KeyCredentialForResource(d *pluginsdk.ResourceData) (*stable.KeyCredential,
 		keyId = kid
 	}
 
+	// Azure defaults to Verify when no usage is specified
+	usage := KeyCredentialUsageVerify
+	if v, ok := in["usage"].(string); ok && v != "" {
+		usage = v
+	}
+
 	credential := stable.KeyCredential{
 		KeyId: nullable.Value(keyId),
 		Type:  nullable.Value(keyType),
-		Usage: nullable.Value(KeyCredentialUsageVerify),
+		Usage: nullable.Value(usage),
 		Key:   nullable.Value(encodedValue),
 	}
 
-	if v, ok := d.GetOk("start_date"); ok {
-		startDate, err := time.Parse(time.RFC3339, v.(string))
-		if err != nil {
-			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
-		}
+	if v, ok := in["display_name"].(string); ok && v != "" {
+		credential.DisplayName = nullable.Value(v)
+	}
+
+	startDate, endDate, err := credentialValidity(in, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if startDate != nil {
 		credential.StartDateTime = nullable.Value(startDate.Format(time.RFC3339))
 	}
+	if endDate != nil {
+		credential.EndDateTime = nullable.Value(endDate.Format(time.RFC3339))
+	}
+
+	return &credential, nil
+}
+
+func KeyCredentialForResource(d *pluginsdk.ResourceData) (*stable.KeyCredential, error) {
+	data := map[string]interface{}{
+		"encoding": d.Get("encoding"),
+		"type":     d.Get("type"),
+		"value":    d.Get("value"),
+	}
+
+	if v, ok := d.GetOk("password"); ok {
+		data["password"] = v
+	}
+
+	if v, ok := d.GetOk("key_id"); ok {
+		data["key_id"] = v
+	}
+
+	if v, ok := d.GetOk("usage"); ok {
+		data["usage"] = v
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		data["display_name"] = v
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		data["start_date"] = v
+	} else if v, ok := d.GetOk("start_date_relative"); ok && v.(string) != "" {
+		data["start_date_relative"] = v
+	}
 
-	var endDate *time.Time
 	if v, ok := d.GetOk("end_date"); ok && v.(string) != "" {
-		var err error
-		expiry, err := time.Parse(time.RFC3339, v.(string))
-		if err != nil {
-			return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided end date %q: %+v", v, err), attr: "end_date"}
-		}
-		endDate = &expiry
+		data["end_date"] = v
 	} else if v, ok := d.GetOk("end_date_relative"); ok && v.(string) != "" {
-		d, err := time.ParseDuration(v.(string))
-		if err != nil {
-			return nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
-		}
-
-		if credential.StartDateTime == nil {
-			expiry := time.Now().Add(d)
-			endDate = &expiry
-		} else {
-			startDateTime, err := time.Parse(time.RFC3339, v.(string))
-			if err != nil {
-				return nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
-			}
-			expiry := startDateTime.Add(d)
-			endDate = &expiry
-		}
+		data["end_date_relative"] = v
 	}
 
-	if endDate != nil {
-		credential.EndDateTime = nullable.Value(endDate.Format(time.RFC3339))
+	if v, ok := d.GetOk(credentialMaximumValidityField); ok && v.(string) != "" {
+		data[credentialMaximumValidityField] = v
 	}
 
-	return &credential, nil
+	return KeyCredential(data)
 }
 
 func PasswordCredential(in map[string]interface{}) (*stable.PasswordCredential, error) {
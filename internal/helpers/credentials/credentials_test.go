@@ -0,0 +1,423 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *rsa.PrivateKey
+	pem  string
+}
+
+// testCertificateChain returns a root CA, an intermediate CA issued by the root, and a leaf certificate issued by the
+// intermediate
+func testCertificateChain(t *testing.T) (root, intermediate, leaf testCertificate) {
+	root = testIssueCertificate(t, "Test Root CA", true, nil)
+	intermediate = testIssueCertificate(t, "Test Intermediate CA", true, &root)
+	leaf = testIssueCertificate(t, "leaf.example.com", false, &intermediate)
+	return
+}
+
+func testIssueCertificate(t *testing.T, commonName string, isCA bool, issuer *testCertificate) testCertificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %+v", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatalf("generating serial number: %+v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: commonName, Organization: []string{"Terraform Testing"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	if isCA {
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	} else {
+		template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatalf("creating certificate: %+v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %+v", err)
+	}
+
+	return testCertificate{
+		cert: cert,
+		key:  key,
+		pem:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func TestKeyCredential_validation(t *testing.T) {
+	certPem, _, cert := testProofCertificate(t)
+	certBase64 := base64.StdEncoding.EncodeToString(cert.Raw)
+	certHex := hex.EncodeToString(cert.Raw)
+	keyPem := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("not a key")}))
+
+	cases := []struct {
+		TestName string
+		Type     string
+		Encoding string
+		Value    string
+		Valid    bool
+	}{
+		{
+			TestName: "ValidPem",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "pem",
+			Value:    certPem,
+			Valid:    true,
+		},
+		{
+			TestName: "ValidPemWithSurroundingNewlines",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "pem",
+			Value:    "\n" + certPem + "\n\n",
+			Valid:    true,
+		},
+		{
+			TestName: "ValidBase64",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "base64",
+			Value:    certBase64,
+			Valid:    true,
+		},
+		{
+			TestName: "ValidHex",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "hex",
+			Value:    certHex,
+			Valid:    true,
+		},
+		{
+			TestName: "InvalidPem",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "pem",
+			Value:    "not a certificate",
+			Valid:    false,
+		},
+		{
+			TestName: "IndentedPem",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "pem",
+			Value:    "  " + strings.ReplaceAll(certPem, "\n", "\n  "),
+			Valid:    false,
+		},
+		{
+			TestName: "PemWrongBlockType",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "pem",
+			Value:    keyPem,
+			Valid:    false,
+		},
+		{
+			TestName: "TruncatedBase64",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "base64",
+			Value:    certBase64[:len(certBase64)/2],
+			Valid:    false,
+		},
+		{
+			TestName: "TruncatedHex",
+			Type:     "AsymmetricX509Cert",
+			Encoding: "hex",
+			Value:    certHex[:len(certHex)/2],
+			Valid:    false,
+		},
+		{
+			TestName: "SymmetricNotValidated",
+			Type:     "Symmetric",
+			Encoding: "pem",
+			Value:    "not a certificate",
+			Valid:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, err := KeyCredential(map[string]interface{}{
+				"type":     tc.Type,
+				"encoding": tc.Encoding,
+				"value":    tc.Value,
+			})
+
+			if tc.Valid && err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if !tc.Valid {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				if kerr, ok := err.(CredentialError); !ok || kerr.Attr() != "value" {
+					t.Fatalf("expected a CredentialError for `value`, got: %+v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestKeyCredential_pemChain(t *testing.T) {
+	root, intermediate, leaf := testCertificateChain(t)
+	otherLeaf := testIssueCertificate(t, "other.example.com", false, &intermediate)
+	unrelated := testIssueCertificate(t, "unrelated.example.com", false, nil)
+
+	cases := []struct {
+		TestName string
+		Value    string
+		Valid    bool
+	}{
+		{
+			TestName: "LeafOnly",
+			Value:    leaf.pem,
+			Valid:    true,
+		},
+		{
+			TestName: "LeafFirst",
+			Value:    leaf.pem + intermediate.pem + root.pem,
+			Valid:    true,
+		},
+		{
+			TestName: "RootFirst",
+			Value:    root.pem + intermediate.pem + leaf.pem,
+			Valid:    true,
+		},
+		{
+			TestName: "Unordered",
+			Value:    intermediate.pem + leaf.pem + root.pem,
+			Valid:    true,
+		},
+		{
+			TestName: "WithoutRoot",
+			Value:    leaf.pem + "\n" + intermediate.pem,
+			Valid:    true,
+		},
+		{
+			TestName: "MultipleLeaves",
+			Value:    leaf.pem + otherLeaf.pem + intermediate.pem + root.pem,
+			Valid:    false,
+		},
+		{
+			TestName: "UnrelatedCertificates",
+			Value:    leaf.pem + unrelated.pem,
+			Valid:    false,
+		},
+		{
+			TestName: "ContainsPrivateKey",
+			Value:    leaf.pem + string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(leaf.key)})),
+			Valid:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			credential, err := KeyCredential(map[string]interface{}{
+				"type":     "AsymmetricX509Cert",
+				"encoding": "pem",
+				"value":    tc.Value,
+			})
+
+			if !tc.Valid {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				if kerr, ok := err.(CredentialError); !ok || kerr.Attr() != "value" {
+					t.Fatalf("expected a CredentialError for `value`, got: %+v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			uploaded, err := base64.StdEncoding.DecodeString(credential.Key.GetOrZero())
+			if err != nil {
+				t.Fatalf("decoding uploaded key: %+v", err)
+			}
+			block, rest := pem.Decode(uploaded)
+			if block == nil {
+				t.Fatalf("uploaded key is not PEM encoded")
+			}
+			if len(strings.TrimSpace(string(rest))) > 0 {
+				t.Fatalf("expected only the leaf certificate to be uploaded, found additional data")
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				t.Fatalf("parsing uploaded certificate: %+v", err)
+			}
+			if !cert.Equal(leaf.cert) {
+				t.Fatalf("expected the leaf certificate %q to be uploaded, got %q", leaf.cert.Subject, cert.Subject)
+			}
+		})
+	}
+}
+
+func TestGetVerifyKeyCredentialIdFromThumbprint(t *testing.T) {
+	_, _, cert := testProofCertificate(t)
+	sum := sha1.Sum(cert.Raw)
+	customKeyIdentifier := nullable.Value(base64.StdEncoding.EncodeToString(sum[:]))
+	thumbprint := strings.ToLower(hex.EncodeToString(sum[:]))
+
+	cases := []struct {
+		TestName       string
+		KeyCredentials []stable.KeyCredential
+		Expected       string
+		Error          bool
+	}{
+		{
+			TestName: "Match",
+			KeyCredentials: []stable.KeyCredential{
+				{KeyId: nullable.Value("11111111-1111-1111-1111-111111111111"), CustomKeyIdentifier: nullable.Value(base64.StdEncoding.EncodeToString([]byte("other"))), Usage: nullable.Value(KeyCredentialUsageVerify)},
+				{KeyId: nullable.Value("22222222-2222-2222-2222-222222222222"), CustomKeyIdentifier: customKeyIdentifier, Usage: nullable.Value(KeyCredentialUsageVerify)},
+			},
+			Expected: "22222222-2222-2222-2222-222222222222",
+		},
+		{
+			TestName: "IgnoresSignUsage",
+			KeyCredentials: []stable.KeyCredential{
+				{KeyId: nullable.Value("11111111-1111-1111-1111-111111111111"), CustomKeyIdentifier: customKeyIdentifier, Usage: nullable.Value(KeyCredentialUsageSign)},
+				{KeyId: nullable.Value("22222222-2222-2222-2222-222222222222"), CustomKeyIdentifier: customKeyIdentifier, Usage: nullable.Value(KeyCredentialUsageVerify)},
+			},
+			Expected: "22222222-2222-2222-2222-222222222222",
+		},
+		{
+			TestName: "NoMatch",
+			KeyCredentials: []stable.KeyCredential{
+				{KeyId: nullable.Value("11111111-1111-1111-1111-111111111111"), CustomKeyIdentifier: nullable.Value(base64.StdEncoding.EncodeToString([]byte("other"))), Usage: nullable.Value(KeyCredentialUsageVerify)},
+			},
+			Error: true,
+		},
+		{
+			TestName: "MultipleMatches",
+			KeyCredentials: []stable.KeyCredential{
+				{KeyId: nullable.Value("11111111-1111-1111-1111-111111111111"), CustomKeyIdentifier: customKeyIdentifier, Usage: nullable.Value(KeyCredentialUsageVerify)},
+				{KeyId: nullable.Value("22222222-2222-2222-2222-222222222222"), CustomKeyIdentifier: customKeyIdentifier, Usage: nullable.Value(KeyCredentialUsageVerify)},
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			keyId, err := GetVerifyKeyCredentialIdFromThumbprint(&tc.KeyCredentials, thumbprint)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if keyId != tc.Expected {
+				t.Fatalf("expected key ID %q, got %q", tc.Expected, keyId)
+			}
+		})
+	}
+}
+
+func TestKeyCredential_usage(t *testing.T) {
+	certPem, _, _ := testProofCertificate(t)
+
+	cases := []struct {
+		TestName string
+		Usage    string
+		Expected string
+	}{
+		{
+			TestName: "Default",
+			Usage:    "",
+			Expected: KeyCredentialUsageVerify,
+		},
+		{
+			TestName: "Verify",
+			Usage:    KeyCredentialUsageVerify,
+			Expected: KeyCredentialUsageVerify,
+		},
+		{
+			TestName: "Sign",
+			Usage:    KeyCredentialUsageSign,
+			Expected: KeyCredentialUsageSign,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			credential, err := KeyCredential(map[string]interface{}{
+				"type":     "AsymmetricX509Cert",
+				"encoding": "pem",
+				"value":    certPem,
+				"usage":    tc.Usage,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if usage := credential.Usage.GetOrZero(); usage != tc.Expected {
+				t.Fatalf("expected usage %q, got %q", tc.Expected, usage)
+			}
+		})
+	}
+}
+
+func TestKeyCredential_displayName(t *testing.T) {
+	certPem, _, _ := testProofCertificate(t)
+
+	credential, err := KeyCredential(map[string]interface{}{
+		"type":         "AsymmetricX509Cert",
+		"encoding":     "pem",
+		"value":        certPem,
+		"display_name": "My Certificate",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if displayName := credential.DisplayName.GetOrZero(); displayName != "My Certificate" {
+		t.Fatalf("expected display name %q, got %q", "My Certificate", displayName)
+	}
+
+	credential, err = KeyCredential(map[string]interface{}{
+		"type":     "AsymmetricX509Cert",
+		"encoding": "pem",
+		"value":    certPem,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if credential.DisplayName != nil {
+		t.Fatalf("expected display name to be omitted, got %q", credential.DisplayName.GetOrZero())
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+)
+
+// ProofAudience is the audience expected by Microsoft Graph in proof of possession tokens for the addKey and
+// removeKey actions, which is the application ID of the legacy Azure AD Graph API.
+const ProofAudience = "00000002-0000-0000-c000-000000000000"
+
+// ProofValidity is the lifetime of a generated proof of possession token. Microsoft Graph rejects tokens which are
+// valid for longer than 10 minutes.
+const ProofValidity = 10 * time.Minute
+
+// ProofOfPossession holds an existing certificate credential and its private key, which are used to sign the proof of
+// possession token required by the addKey and removeKey actions.
+type ProofOfPossession struct {
+	Certificate *x509.Certificate
+	privateKey  *rsa.PrivateKey
+}
+
+// NewProofOfPossession parses a PEM encoded certificate and a PEM encoded RSA private key, in either PKCS#1 or PKCS#8
+// format, and ensures that the private key corresponds to the certificate.
+func NewProofOfPossession(certificatePem, privateKeyPem string) (*ProofOfPossession, error) {
+	certBlock, _ := pem.Decode([]byte(certificatePem))
+	if certBlock == nil {
+		return nil, CredentialError{str: "failed to decode PEM proof certificate", attr: "proof_certificate"}
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, CredentialError{str: fmt.Sprintf("failed to parse proof certificate: %+v", err), attr: "proof_certificate"}
+	}
+
+	keyBlock, _ := pem.Decode([]byte(privateKeyPem))
+	if keyBlock == nil {
+		return nil, CredentialError{str: "failed to decode PEM proof private key", attr: "proof_private_key"}
+	}
+
+	var privateKey *rsa.PrivateKey
+	if key, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes); err == nil {
+		privateKey = key
+	} else if key, err := x509.ParsePKCS8PrivateKey(keyBlock.Bytes); err == nil {
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, CredentialError{str: "proof private key must be an RSA private key", attr: "proof_private_key"}
+		}
+		privateKey = rsaKey
+	} else {
+		return nil, CredentialError{str: "failed to parse proof private key, expected a PKCS#1 or PKCS#8 RSA private key", attr: "proof_private_key"}
+	}
+
+	if !privateKey.PublicKey.Equal(cert.PublicKey) {
+		return nil, CredentialError{str: "proof private key does not match the proof certificate", attr: "proof_private_key"}
+	}
+
+	return &ProofOfPossession{
+		Certificate: cert,
+		privateKey:  privateKey,
+	}, nil
+}
+
+// Thumbprint returns the uppercase hex encoded SHA-1 thumbprint of the proof certificate, as returned by
+// GetKeyCredentialThumbprint.
+func (p ProofOfPossession) Thumbprint() string {
+	thumbprint := sha1.Sum(p.Certificate.Raw)
+	return strings.ToUpper(hex.EncodeToString(thumbprint[:]))
+}
+
+// Token generates a signed JWT proving possession of the private key for the proof certificate. The token has the
+// following form, as expected by Microsoft Graph:
+//
+//	Header: {"alg": "RS256", "typ": "JWT", "x5t": "<base64url encoded SHA-1 thumbprint of the proof certificate>"}
+//	Claims: {"aud": "00000002-0000-0000-c000-000000000000", "iss": "<object ID>", "nbf": <now>, "exp": <now + 10m>}
+//
+// The object ID is that of the application or service principal to which the key is being added or removed.
+func (p ProofOfPossession) Token(objectId string, now time.Time) (string, error) {
+	thumbprint := sha1.Sum(p.Certificate.Raw)
+
+	header, err := json.Marshal(map[string]interface{}{
+		"alg": "RS256",
+		"typ": "JWT",
+		"x5t": base64.RawURLEncoding.EncodeToString(thumbprint[:]),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshaling proof token header: %+v", err)
+	}
+
+	claims, err := json.Marshal(map[string]interface{}{
+		"aud": ProofAudience,
+		"iss": objectId,
+		"nbf": now.Unix(),
+		"exp": now.Add(ProofValidity).Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshaling proof token claims: %+v", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("signing proof token: %+v", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// ValidateProofOfPossession ensures that the proof certificate corresponds to one of the provided key credentials, so
+// that an invalid proof is detected before calling the addKey or removeKey actions.
+func ValidateProofOfPossession(proof ProofOfPossession, keyCredentials *[]stable.KeyCredential) error {
+	thumbprint := proof.Thumbprint()
+	if keyCredentials != nil {
+		for _, cred := range *keyCredentials {
+			if strings.EqualFold(GetKeyCredentialThumbprint(cred), thumbprint) {
+				return nil
+			}
+		}
+	}
+
+	return CredentialError{str: fmt.Sprintf("proof certificate with thumbprint %s does not match any existing certificate credential", thumbprint), attr: "proof_certificate"}
+}
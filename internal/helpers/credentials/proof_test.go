@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+func testProofCertificate(t *testing.T) (string, string, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %+v", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "proof"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %+v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %+v", err)
+	}
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	return string(certPem), string(keyPem), cert
+}
+
+func TestProofOfPossessionToken(t *testing.T) {
+	certPem, keyPem, cert := testProofCertificate(t)
+
+	proof, err := NewProofOfPossession(certPem, keyPem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	now := time.Unix(1700000000, 0)
+	token, err := proof.Token("11111111-1111-1111-1111-111111111111", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("expected 3 token segments, got %d", len(parts))
+	}
+
+	claimsJson, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("decoding claims: %+v", err)
+	}
+	claims := make(map[string]interface{})
+	if err = json.Unmarshal(claimsJson, &claims); err != nil {
+		t.Fatalf("unmarshaling claims: %+v", err)
+	}
+	if claims["aud"] != ProofAudience {
+		t.Fatalf("unexpected aud claim: %v", claims["aud"])
+	}
+	if claims["iss"] != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("unexpected iss claim: %v", claims["iss"])
+	}
+	if claims["nbf"] != float64(1700000000) || claims["exp"] != float64(1700000600) {
+		t.Fatalf("unexpected validity claims: nbf=%v exp=%v", claims["nbf"], claims["exp"])
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("decoding signature: %+v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err = rsa.VerifyPKCS1v15(cert.PublicKey.(*rsa.PublicKey), crypto.SHA256, digest[:], signature); err != nil {
+		t.Fatalf("verifying signature: %+v", err)
+	}
+}
+
+func TestNewProofOfPossession_mismatchedKey(t *testing.T) {
+	certPem, _, _ := testProofCertificate(t)
+	_, keyPem, _ := testProofCertificate(t)
+
+	_, err := NewProofOfPossession(certPem, keyPem)
+	if err == nil {
+		t.Fatalf("expected an error for a mismatched private key")
+	}
+	if kerr, ok := err.(CredentialError); !ok || kerr.Attr() != "proof_private_key" {
+		t.Fatalf("expected a CredentialError for `proof_private_key`, got: %+v", err)
+	}
+}
+
+func TestValidateProofOfPossession(t *testing.T) {
+	certPem, keyPem, cert := testProofCertificate(t)
+
+	proof, err := NewProofOfPossession(certPem, keyPem)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	thumbprint, err := GetTokenSigningCertificateThumbprint([]byte(certPem))
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if proof.Thumbprint() != thumbprint {
+		t.Fatalf("expected thumbprint %s, got %s", thumbprint, proof.Thumbprint())
+	}
+
+	sum := sha1.Sum(cert.Raw)
+	customKeyIdentifier := base64.StdEncoding.EncodeToString(sum[:])
+
+	matching := []stable.KeyCredential{{CustomKeyIdentifier: nullable.Value(customKeyIdentifier)}}
+	if err = ValidateProofOfPossession(*proof, &matching); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	other := []stable.KeyCredential{{CustomKeyIdentifier: nullable.Value(base64.StdEncoding.EncodeToString([]byte("not a thumbprint")))}}
+	if err = ValidateProofOfPossession(*proof, &other); err == nil {
+		t.Fatalf("expected an error when the proof certificate does not match an existing credential")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// CertificateThumbprintPrefix denotes a hex encoded SHA-1 certificate thumbprint in place of the key ID in a certificate
+// ID, i.e. `{objectId}/certificate/thumbprint:{thumbprint}`. This form is only supported when importing.
+const CertificateThumbprintPrefix = "thumbprint:"
+
+// IsCertificateThumbprintID reports whether a certificate ID specifies a certificate thumbprint in place of the key ID
+func IsCertificateThumbprintID(idString string) bool {
+	parts := strings.Split(idString, "/")
+	return len(parts) == 3 && strings.HasPrefix(parts[2], CertificateThumbprintPrefix)
+}
+
+// ParseCertificateThumbprintID parses a certificate ID in the form `{objectId}/certificate/thumbprint:{thumbprint}`,
+// returning the object ID and the thumbprint in upper case
+func ParseCertificateThumbprintID(idString string) (objectId string, thumbprint string, err error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 3 || !strings.HasPrefix(parts[2], CertificateThumbprintPrefix) {
+		return "", "", fmt.Errorf("unable to parse Certificate ID: expected the format {objectID}/{type}/thumbprint:{thumbprint}, got %q", idString)
+	}
+
+	objectId = parts[0]
+	thumbprint = strings.ToUpper(strings.TrimPrefix(parts[2], CertificateThumbprintPrefix))
+
+	if _, err = uuid.ParseUUID(objectId); err != nil {
+		return "", "", fmt.Errorf("unable to parse Certificate ID: Object ID isn't a valid UUID (%q): %+v", objectId, err)
+	}
+
+	if parts[1] != "certificate" {
+		return "", "", fmt.Errorf("unable to parse Certificate ID: Type in {objectID}/{type}/thumbprint:{thumbprint} was expected to be certificate, got %s", parts[1])
+	}
+
+	if decoded, err := hex.DecodeString(thumbprint); err != nil || len(decoded) != sha1.Size {
+		return "", "", fmt.Errorf("unable to parse Certificate ID: thumbprint should be a hex encoded SHA-1 hash, got %q", thumbprint)
+	}
+
+	return objectId, thumbprint, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"testing"
+)
+
+func TestParseCertificateThumbprintID(t *testing.T) {
+	testCases := []struct {
+		name         string
+		input        string
+		isThumbprint bool
+		objectId     string
+		thumbprint   string
+		hasError     bool
+	}{
+		{
+			name:         "valid lower case thumbprint",
+			input:        "00000000-0000-0000-0000-000000000000/certificate/thumbprint:0123456789abcdef0123456789abcdef01234567",
+			isThumbprint: true,
+			objectId:     "00000000-0000-0000-0000-000000000000",
+			thumbprint:   "0123456789ABCDEF0123456789ABCDEF01234567",
+		},
+		{
+			name:         "invalid object ID",
+			isThumbprint: true,
+			input:        "not-a-uuid/certificate/thumbprint:0123456789abcdef0123456789abcdef01234567",
+			hasError:     true,
+		},
+		{
+			name:         "wrong type",
+			isThumbprint: true,
+			input:        "00000000-0000-0000-0000-000000000000/password/thumbprint:0123456789abcdef0123456789abcdef01234567",
+			hasError:     true,
+		},
+		{
+			name:         "thumbprint too short",
+			isThumbprint: true,
+			input:        "00000000-0000-0000-0000-000000000000/certificate/thumbprint:0123456789abcdef",
+			hasError:     true,
+		},
+		{
+			name:     "key ID",
+			input:    "00000000-0000-0000-0000-000000000000/certificate/11111111-1111-1111-1111-111111111111",
+			hasError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if IsCertificateThumbprintID(tc.input) != tc.isThumbprint {
+				t.Fatalf("unexpected result from IsCertificateThumbprintID for %q", tc.input)
+			}
+
+			objectId, thumbprint, err := ParseCertificateThumbprintID(tc.input)
+			if tc.hasError {
+				if err == nil {
+					t.Fatalf("expected an error for %q, got none", tc.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if objectId != tc.objectId {
+				t.Fatalf("expected object ID %q, got %q", tc.objectId, objectId)
+			}
+			if thumbprint != tc.thumbprint {
+				t.Fatalf("expected thumbprint %q, got %q", tc.thumbprint, thumbprint)
+			}
+		})
+	}
+}
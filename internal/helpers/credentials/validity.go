@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// ShortValidityThreshold is the validity period below which a warning is raised for a new credential, since a
+// credential which expires so soon after it becomes valid is usually a mistake.
+const ShortValidityThreshold = 24 * time.Hour
+
+// DefaultExpiryWarningDays is the default number of days before the end date of a credential from which a warning is
+// raised when reading the credential
+const DefaultExpiryWarningDays = 30
+
+// credentialMaximumValidityField is the optional field specifying the maximum permitted validity period of a credential
+const credentialMaximumValidityField = "maximum_validity"
+
+// credentialValidityFields are the fields from which the validity period of a credential is resolved
+var credentialValidityFields = []string{"start_date", "start_date_relative", "end_date", "end_date_relative"}
+
+// credentialValidity resolves the start and end dates of a credential from the `start_date`, `start_date_relative`,
+// `end_date` and `end_date_relative` fields, relative to the provided time. A nil start or end date is returned when
+// the respective date is not specified, in which case the API determines the date. An error is returned when the
+// resolved end date is not after the start date, or when the validity period exceeds any `maximum_validity`.
+func credentialValidity(in map[string]interface{}, now time.Time) (startDate *time.Time, endDate *time.Time, err error) {
+	if v, ok := in["start_date"]; ok && v.(string) != "" {
+		start, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided start date %q: %+v", v, err), attr: "start_date"}
+		}
+		startDate = &start
+	} else if v, ok := in["start_date_relative"]; ok && v.(string) != "" {
+		duration, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, nil, CredentialError{str: fmt.Sprintf("Unable to parse `start_date_relative` (%q) as a duration", v), attr: "start_date_relative"}
+		}
+		start := now.Add(duration)
+		startDate = &start
+	}
+
+	start := now
+	if startDate != nil {
+		start = *startDate
+	}
+
+	endAttr := "end_date"
+	if v, ok := in["end_date"]; ok && v.(string) != "" {
+		expiry, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return nil, nil, CredentialError{str: fmt.Sprintf("Unable to parse the provided end date %q: %+v", v, err), attr: "end_date"}
+		}
+		endDate = &expiry
+	} else if v, ok := in["end_date_relative"]; ok && v.(string) != "" {
+		duration, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, nil, CredentialError{str: fmt.Sprintf("Unable to parse `end_date_relative` (%q) as a duration", v), attr: "end_date_relative"}
+		}
+		expiry := start.Add(duration)
+		endDate = &expiry
+		endAttr = "end_date_relative"
+	}
+
+	if endDate != nil && !endDate.After(start) {
+		return nil, nil, CredentialError{str: fmt.Sprintf("the end date (%s) must be after the start date (%s)", endDate.Format(time.RFC3339), start.Format(time.RFC3339)), attr: endAttr}
+	}
+
+	if v, ok := in[credentialMaximumValidityField]; ok && v.(string) != "" && endDate != nil {
+		maximum, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, nil, CredentialError{str: fmt.Sprintf("Unable to parse `%s` (%q) as a duration", credentialMaximumValidityField, v), attr: credentialMaximumValidityField}
+		}
+		if validity := endDate.Sub(start); validity > maximum {
+			return nil, nil, CredentialError{str: fmt.Sprintf("the validity period (%s) exceeds the maximum of %s specified by `%s`", validity.Round(time.Second), maximum, credentialMaximumValidityField), attr: endAttr}
+		}
+	}
+
+	return startDate, endDate, nil
+}
+
+// ValidityCustomizeDiff ensures at plan time that the end date of a credential is after its start date, and that the
+// validity period does not exceed any `maximum_validity`. Any of the `start_date`, `start_date_relative`, `end_date`
+// and `end_date_relative` fields present in the configuration are considered, and validation is skipped when any of
+// these are not yet known.
+func ValidityCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	// Only validate when a credential is being created, since relative dates are resolved at that time
+	if diff.Id() != "" && !diff.HasChanges(credentialValidityFields...) {
+		return nil
+	}
+
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	in := make(map[string]interface{})
+	values := config.AsValueMap()
+	for _, field := range append(credentialValidityFields, credentialMaximumValidityField) {
+		v, ok := values[field]
+		if !ok || v.IsNull() {
+			continue
+		}
+		if !v.IsKnown() {
+			return nil
+		}
+		in[field] = v.AsString()
+	}
+
+	if _, _, err := credentialValidity(in, time.Now()); err != nil {
+		if cerr, ok := err.(CredentialError); ok {
+			return fmt.Errorf("`%s`: %s", cerr.Attr(), cerr.Error())
+		}
+		return err
+	}
+
+	return nil
+}
+
+// EndDateAttr returns the attribute which determines the end date of a credential, for inclusion in errors
+// returned when a credential is rejected due to its validity period
+func EndDateAttr(d *pluginsdk.ResourceData) string {
+	if v, ok := d.GetOk("end_date_relative"); ok && v.(string) != "" {
+		return "end_date_relative"
+	}
+	return "end_date"
+}
+
+// policyViolationErrorCodes are the error codes returned by the API when a credential does not satisfy an app
+// management policy
+var policyViolationErrorCodes = []string{
+	"CredentialInvalidLifetimeAsPerAppPolicy",
+	"CredentialTypeNotAllowedAsPerAppPolicy",
+}
+
+// IsPolicyViolation returns true when the API rejected a credential because it does not satisfy an app management
+// policy, such as a policy restricting the maximum lifetime of certificates. The error code is matched where the API
+// returns one, otherwise the error message is matched.
+func IsPolicyViolation(o *odata.OData) bool {
+	if o == nil || o.Error == nil {
+		return false
+	}
+
+	if o.Error.Code == nil {
+		return o.Error.Match("(?i)(as per|by) (the )?assigned policy")
+	}
+
+	for e := o.Error; e != nil; e = e.InnerError {
+		if e.Code != nil && slices.ContainsFunc(policyViolationErrorCodes, func(code string) bool { return strings.EqualFold(code, *e.Code) }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// KeyCredentialValidityWarnings returns a warning when the validity period of a new key credential is shorter than
+// ShortValidityThreshold
+func KeyCredentialValidityWarnings(credential stable.KeyCredential, now time.Time) pluginsdk.Diagnostics {
+	return validityWarnings("certificate", credential.StartDateTime.GetOrZero(), credential.EndDateTime.GetOrZero(), now)
+}
+
+func validityWarnings(kind, startDateTime, endDateTime string, now time.Time) pluginsdk.Diagnostics {
+	if endDateTime == "" {
+		return nil
+	}
+	end, err := time.Parse(time.RFC3339, endDateTime)
+	if err != nil {
+		return nil
+	}
+
+	start := now
+	if startDateTime != "" {
+		if start, err = time.Parse(time.RFC3339, startDateTime); err != nil {
+			return nil
+		}
+	}
+
+	if validity := end.Sub(start); validity < ShortValidityThreshold {
+		return pluginsdk.Diagnostics{{
+			Severity: pluginsdk.DiagWarning,
+			Summary:  fmt.Sprintf("The %s is only valid for %s", kind, validity.Round(time.Second)),
+			Detail:   fmt.Sprintf("The %s will expire at %s, which is less than %s after it becomes valid. Check that the `end_date` or `end_date_relative` property is correct.", kind, end.Format(time.RFC3339), ShortValidityThreshold),
+		}}
+	}
+
+	return nil
+}
+
+// KeyCredentialExpiryWarnings returns a warning when the end date of an existing key credential is within the provided
+// number of days from now, or has already passed. No warning is returned when days is zero.
+func KeyCredentialExpiryWarnings(credential stable.KeyCredential, days int, now time.Time) pluginsdk.Diagnostics {
+	return expiryWarnings("certificate", credential.KeyId.GetOrZero(), credential.EndDateTime.GetOrZero(), days, now)
+}
+
+func expiryWarnings(kind, keyId, endDateTime string, days int, now time.Time) pluginsdk.Diagnostics {
+	if days <= 0 || endDateTime == "" {
+		return nil
+	}
+	end, err := time.Parse(time.RFC3339, endDateTime)
+	if err != nil {
+		return nil
+	}
+
+	if !end.After(now) {
+		return pluginsdk.Diagnostics{{
+			Severity: pluginsdk.DiagWarning,
+			Summary:  fmt.Sprintf("The %s %q has expired", kind, keyId),
+			Detail:   fmt.Sprintf("The %s expired at %s and should be rotated.", kind, end.Format(time.RFC3339)),
+		}}
+	}
+
+	if remaining := end.Sub(now); remaining < time.Duration(days)*24*time.Hour {
+		return pluginsdk.Diagnostics{{
+			Severity: pluginsdk.DiagWarning,
+			Summary:  fmt.Sprintf("The %s %q expires in %d day(s)", kind, keyId, int(remaining.Hours()/24)),
+			Detail:   fmt.Sprintf("The %s will expire at %s, which is within %d day(s). It should be rotated before it expires.", kind, end.Format(time.RFC3339), days),
+		}}
+	}
+
+	return nil
+}
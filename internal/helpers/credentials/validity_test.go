@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+func TestCredentialValidity(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		TestName  string
+		Input     map[string]interface{}
+		ErrorAttr string
+	}{
+		{
+			TestName: "NoDates",
+			Input:    map[string]interface{}{},
+		},
+		{
+			TestName: "EndDateAfterStartDate",
+			Input: map[string]interface{}{
+				"start_date": "2024-02-01T00:00:00Z",
+				"end_date":   "2025-02-01T00:00:00Z",
+			},
+		},
+		{
+			TestName: "EndDateBeforeStartDate",
+			Input: map[string]interface{}{
+				"start_date": "2024-02-01T00:00:00Z",
+				"end_date":   "2024-01-15T00:00:00Z",
+			},
+			ErrorAttr: "end_date",
+		},
+		{
+			TestName: "EndDateEqualsStartDate",
+			Input: map[string]interface{}{
+				"start_date": "2024-02-01T00:00:00Z",
+				"end_date":   "2024-02-01T00:00:00Z",
+			},
+			ErrorAttr: "end_date",
+		},
+		{
+			TestName: "EndDateInPast",
+			Input: map[string]interface{}{
+				"end_date": "2023-12-31T00:00:00Z",
+			},
+			ErrorAttr: "end_date",
+		},
+		{
+			TestName: "EndDateBeforeRelativeStartDate",
+			Input: map[string]interface{}{
+				"start_date_relative": "720h",
+				"end_date":            "2024-01-15T00:00:00Z",
+			},
+			ErrorAttr: "end_date",
+		},
+		{
+			TestName: "RelativeEndDate",
+			Input: map[string]interface{}{
+				"start_date":        "2024-02-01T00:00:00Z",
+				"end_date_relative": "240h",
+			},
+		},
+		{
+			TestName: "NegativeRelativeEndDate",
+			Input: map[string]interface{}{
+				"end_date_relative": "-1h",
+			},
+			ErrorAttr: "end_date_relative",
+		},
+		{
+			TestName: "WithinMaximumValidity",
+			Input: map[string]interface{}{
+				"end_date_relative": "8760h",
+				"maximum_validity":  "17520h",
+			},
+		},
+		{
+			TestName: "RelativeEndDateExceedsMaximumValidity",
+			Input: map[string]interface{}{
+				"end_date_relative": "26280h",
+				"maximum_validity":  "17520h",
+			},
+			ErrorAttr: "end_date_relative",
+		},
+		{
+			TestName: "EndDateExceedsMaximumValidity",
+			Input: map[string]interface{}{
+				"start_date":       "2024-02-01T00:00:00Z",
+				"end_date":         "2027-02-01T00:00:00Z",
+				"maximum_validity": "17520h",
+			},
+			ErrorAttr: "end_date",
+		},
+		{
+			TestName: "InvalidMaximumValidity",
+			Input: map[string]interface{}{
+				"end_date_relative": "240h",
+				"maximum_validity":  "2 years",
+			},
+			ErrorAttr: "maximum_validity",
+		},
+		{
+			TestName: "InvalidStartDate",
+			Input: map[string]interface{}{
+				"start_date": "tomorrow",
+			},
+			ErrorAttr: "start_date",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, _, err := credentialValidity(tc.Input, now)
+			if tc.ErrorAttr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error but got none")
+			}
+			if kerr, ok := err.(CredentialError); !ok || kerr.Attr() != tc.ErrorAttr {
+				t.Fatalf("expected a CredentialError for `%s`, got: %+v", tc.ErrorAttr, err)
+			}
+		})
+	}
+}
+
+func TestKeyCredentialValidityWarnings(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		TestName    string
+		StartDate   string
+		EndDate     string
+		ExpectWarns bool
+	}{
+		{
+			TestName: "NoEndDate",
+		},
+		{
+			TestName: "LongValidity",
+			EndDate:  "2025-01-01T00:00:00Z",
+		},
+		{
+			TestName:    "ShortValidityFromNow",
+			EndDate:     "2024-01-01T12:00:00Z",
+			ExpectWarns: true,
+		},
+		{
+			TestName:    "ShortValidityFromStartDate",
+			StartDate:   "2024-06-01T00:00:00Z",
+			EndDate:     "2024-06-01T23:00:00Z",
+			ExpectWarns: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			credential := stable.KeyCredential{}
+			if tc.StartDate != "" {
+				credential.StartDateTime = nullable.Value(tc.StartDate)
+			}
+			if tc.EndDate != "" {
+				credential.EndDateTime = nullable.Value(tc.EndDate)
+			}
+
+			diags := KeyCredentialValidityWarnings(credential, now)
+			if tc.ExpectWarns && len(diags) != 1 {
+				t.Fatalf("expected 1 warning, got %d", len(diags))
+			}
+			if !tc.ExpectWarns && len(diags) != 0 {
+				t.Fatalf("expected no warnings, got %d", len(diags))
+			}
+		})
+	}
+}
+
+func TestKeyCredentialExpiryWarnings(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		TestName    string
+		EndDate     string
+		Days        int
+		ExpectWarns bool
+	}{
+		{
+			TestName: "NoEndDate",
+			Days:     30,
+		},
+		{
+			TestName: "NotExpiringSoon",
+			EndDate:  "2024-03-01T00:00:00Z",
+			Days:     30,
+		},
+		{
+			TestName:    "ExpiringSoon",
+			EndDate:     "2024-01-15T00:00:00Z",
+			Days:        30,
+			ExpectWarns: true,
+		},
+		{
+			TestName:    "Expired",
+			EndDate:     "2023-12-01T00:00:00Z",
+			Days:        30,
+			ExpectWarns: true,
+		},
+		{
+			TestName: "Disabled",
+			EndDate:  "2024-01-15T00:00:00Z",
+			Days:     0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			credential := stable.KeyCredential{
+				KeyId: nullable.Value("11111111-1111-1111-1111-111111111111"),
+			}
+			if tc.EndDate != "" {
+				credential.EndDateTime = nullable.Value(tc.EndDate)
+			}
+
+			diags := KeyCredentialExpiryWarnings(credential, tc.Days, now)
+			if tc.ExpectWarns && len(diags) != 1 {
+				t.Fatalf("expected 1 warning, got %d", len(diags))
+			}
+			if !tc.ExpectWarns && len(diags) != 0 {
+				t.Fatalf("expected no warnings, got %d", len(diags))
+			}
+		})
+	}
+}
+
+func TestIsPolicyViolation(t *testing.T) {
+	cases := []struct {
+		TestName string
+		Input    string
+		Expected bool
+	}{
+		{
+			TestName: "NoError",
+			Input:    `{}`,
+		},
+		{
+			TestName: "CredentialLifetime",
+			Input:    `{"error":{"code":"CredentialInvalidLifetimeAsPerAppPolicy","message":"Credential lifetime exceeds the max value allowed as per assigned policy 'Default Policy'.","innerError":{"date":"2024-03-12T09:31:45","request-id":"7b1ab8ef-08f4-4b6d-9bd5-0d8a5f3e6c1a","client-request-id":"7b1ab8ef-08f4-4b6d-9bd5-0d8a5f3e6c1a"}}}`,
+			Expected: true,
+		},
+		{
+			TestName: "CredentialType",
+			Input:    `{"error":{"code":"CredentialTypeNotAllowedAsPerAppPolicy","message":"Credential type not allowed as per assigned policy.","innerError":{"date":"2024-03-12T09:35:02","request-id":"d0a4c2b6-52f4-4f3e-8d0e-3a0f0e3c4b8d","client-request-id":"d0a4c2b6-52f4-4f3e-8d0e-3a0f0e3c4b8d"}}}`,
+			Expected: true,
+		},
+		{
+			TestName: "OtherErrorMentioningPolicy",
+			Input:    `{"error":{"code":"Authorization_RequestDenied","message":"Insufficient privileges to complete the operation. Access is denied by the assigned policy.","innerError":{"date":"2024-03-12T09:40:11","request-id":"3c8f1f5e-1a7b-4f61-9e1e-5b2a4d9c7e60","client-request-id":"3c8f1f5e-1a7b-4f61-9e1e-5b2a4d9c7e60"}}}`,
+		},
+		{
+			TestName: "OtherError",
+			Input:    `{"error":{"code":"Request_BadRequest","message":"Invalid value specified for property 'endDateTime' of resource 'PasswordCredential'.","innerError":{"date":"2024-03-12T09:42:27","request-id":"a6e2d1c0-9b3f-4d8a-b7e5-1f0c2e4d6a8b","client-request-id":"a6e2d1c0-9b3f-4d8a-b7e5-1f0c2e4d6a8b"}}}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			var o odata.OData
+			if err := json.Unmarshal([]byte(tc.Input), &o); err != nil {
+				t.Fatalf("unmarshaling error payload: %+v", err)
+			}
+			if result := IsPolicyViolation(&o); result != tc.Expected {
+				t.Fatalf("expected %t, got %t", tc.Expected, result)
+			}
+		})
+	}
+
+	if IsPolicyViolation(nil) {
+		t.Fatal("expected false for a nil OData")
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licenses
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+// AssignedLicenseFunc retrieves a license assigned to a user or group, returning whether the license is assigned along
+// with its disabled plans
+type AssignedLicenseFunc func(ctx context.Context) (assigned bool, disabledPlans *[]string, err error)
+
+func SkuIdSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description:  "The unique identifier of the SKU to assign",
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+}
+
+func DisabledPlansSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: "A set of service plan IDs which should be disabled for this license",
+		Type:        pluginsdk.TypeSet,
+		Optional:    true,
+		Set:         pluginsdk.HashString,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+// DisabledPlansEqual returns whether the disabled plans of an assigned license match the expected service plan IDs,
+// ignoring order and case
+func DisabledPlansEqual(actual *[]string, expected []string) bool {
+	existing := pointer.From(actual)
+	if len(existing) != len(expected) {
+		return false
+	}
+
+	for _, plan := range expected {
+		if !slices.ContainsFunc(existing, func(v string) bool { return strings.EqualFold(v, plan) }) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// WaitForAssignment waits for a license to be reflected as assigned with the expected disabled plans
+func WaitForAssignment(ctx context.Context, disabledPlans []string, f AssignedLicenseFunc) error {
+	return consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		assigned, existing, err := f(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(assigned && DisabledPlansEqual(existing, disabledPlans)), nil
+	})
+}
+
+// WaitForRemoval waits for a license to no longer be reflected as assigned
+func WaitForRemoval(ctx context.Context, f AssignedLicenseFunc) error {
+	return consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		assigned, _, err := f(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(assigned), nil
+	})
+}
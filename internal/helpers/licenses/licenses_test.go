@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licenses
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestDisabledPlansEqual(t *testing.T) {
+	testCases := []struct {
+		name     string
+		actual   *[]string
+		expected []string
+		result   bool
+	}{
+		{
+			name:   "both empty",
+			actual: nil,
+			result: true,
+		},
+		{
+			name:     "nil actual",
+			actual:   nil,
+			expected: []string{"11111111-1111-1111-1111-111111111111"},
+			result:   false,
+		},
+		{
+			name:     "different order and case",
+			actual:   pointer.To([]string{"22222222-2222-2222-2222-22222222222A", "11111111-1111-1111-1111-111111111111"}),
+			expected: []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-22222222222a"},
+			result:   true,
+		},
+		{
+			name:     "different plans",
+			actual:   pointer.To([]string{"11111111-1111-1111-1111-111111111111"}),
+			expected: []string{"22222222-2222-2222-2222-222222222222"},
+			result:   false,
+		},
+		{
+			name:     "additional plan",
+			actual:   pointer.To([]string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222"}),
+			expected: []string{"11111111-1111-1111-1111-111111111111"},
+			result:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := DisabledPlansEqual(tc.actual, tc.expected); result != tc.result {
+				t.Fatalf("expected %t, got %t", tc.result, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyassignments
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// ListFunc retrieves the policies assigned to an application or service principal, along with the HTTP response so that
+// an object which does not exist can be detected
+type ListFunc[T stable.DirectoryObject] func(ctx context.Context) (*http.Response, *[]T, error)
+
+// AddFunc assigns a policy by adding the provided reference
+type AddFunc func(ctx context.Context, ref stable.ReferenceCreate) error
+
+// RemoveFunc unassigns a policy by removing its reference, returning the HTTP response so that a reference which no
+// longer exists can be detected
+type RemoveFunc func(ctx context.Context) (*http.Response, error)
+
+// Assignment describes the assignment of a policy to an application or service principal
+type Assignment[T stable.DirectoryObject] struct {
+	// ID is the resource ID of the assignment
+	ID resourceids.ResourceId
+
+	// PolicyId is the object ID of the assigned policy
+	PolicyId string
+
+	// List retrieves the policies assigned to the application or service principal
+	List ListFunc[T]
+}
+
+// Exists returns whether the policy is currently assigned. An application or service principal which does not exist is
+// treated as having no assigned policies.
+func (a Assignment[T]) Exists(ctx context.Context) (*bool, error) {
+	resp, policies, err := a.List(ctx)
+	if err != nil {
+		if response.WasNotFound(resp) {
+			return pointer.To(false), nil
+		}
+		return nil, err
+	}
+
+	if policies == nil {
+		return nil, errors.New("model was nil")
+	}
+
+	for _, p := range *policies {
+		if strings.EqualFold(pointer.From(p.DirectoryObject().Id), a.PolicyId) {
+			return pointer.To(true), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+// Create assigns the policy and waits for the assignment to be reflected. The resource ID is set once the policy has
+// been assigned. When the policy is already assigned, an error is returned advising that the assignment be imported.
+func (a Assignment[T]) Create(ctx context.Context, d *pluginsdk.ResourceData, resourceType string, baseUri string, add AddFunc) pluginsdk.Diagnostics {
+	existing, err := a.Exists(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing %s", a.ID)
+	}
+	if existing != nil && *existing {
+		return tf.ImportAsExistsDiag(resourceType, a.ID.ID())
+	}
+
+	ref := stable.ReferenceCreate{
+		ODataId: pointer.To(baseUri + stable.NewDirectoryObjectID(a.PolicyId).ID()),
+	}
+
+	if err = add(ctx, ref); err != nil {
+		return tf.ErrorDiagF(err, "Creating %s", a.ID)
+	}
+
+	d.SetId(a.ID.ID())
+
+	if err = consistency.WaitForUpdate(ctx, a.Exists); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", a.ID)
+	}
+
+	return nil
+}
+
+// Delete unassigns the policy and waits for the removal to be reflected. A policy which is no longer assigned is not
+// treated as an error.
+func (a Assignment[T]) Delete(ctx context.Context, remove RemoveFunc) pluginsdk.Diagnostics {
+	if resp, err := remove(ctx); err != nil {
+		if response.WasNotFound(resp) {
+			log.Printf("[DEBUG] %s was already removed", a.ID)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing %s", a.ID)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, a.Exists); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", a.ID)
+	}
+
+	return nil
+}
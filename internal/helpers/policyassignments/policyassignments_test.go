@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyassignments
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+)
+
+func TestAssignmentExists(t *testing.T) {
+	policyId := "11111111-1111-1111-1111-11111111111a"
+
+	testCases := []struct {
+		name     string
+		resp     *http.Response
+		policies *[]stable.TokenIssuancePolicy
+		err      error
+		expected *bool
+		hasError bool
+	}{
+		{
+			name:     "assigned with different case",
+			resp:     &http.Response{StatusCode: http.StatusOK},
+			policies: &[]stable.TokenIssuancePolicy{{Id: pointer.To("22222222-2222-2222-2222-222222222222")}, {Id: pointer.To("11111111-1111-1111-1111-11111111111A")}},
+			expected: pointer.To(true),
+		},
+		{
+			name:     "not assigned",
+			resp:     &http.Response{StatusCode: http.StatusOK},
+			policies: &[]stable.TokenIssuancePolicy{{Id: pointer.To("22222222-2222-2222-2222-222222222222")}},
+			expected: pointer.To(false),
+		},
+		{
+			name:     "object not found",
+			resp:     &http.Response{StatusCode: http.StatusNotFound},
+			err:      errors.New("not found"),
+			expected: pointer.To(false),
+		},
+		{
+			name:     "request failed",
+			resp:     &http.Response{StatusCode: http.StatusInternalServerError},
+			err:      errors.New("internal server error"),
+			hasError: true,
+		},
+		{
+			name:     "nil model",
+			resp:     &http.Response{StatusCode: http.StatusOK},
+			hasError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			id := stable.NewApplicationIdTokenIssuancePolicyID("33333333-3333-3333-3333-333333333333", policyId)
+			a := Assignment[stable.TokenIssuancePolicy]{
+				ID:       &id,
+				PolicyId: policyId,
+				List: func(ctx context.Context) (*http.Response, *[]stable.TokenIssuancePolicy, error) {
+					return tc.resp, tc.policies, tc.err
+				},
+			}
+
+			result, err := a.Exists(context.Background())
+			if tc.hasError {
+				if err == nil {
+					t.Fatal("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if result == nil || *result != *tc.expected {
+				t.Fatalf("expected %t, got %v", *tc.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var iso8601DurationRegex = regexp.MustCompile(`^P(?:(\d+Y)?(\d+M)?(\d+W)?(\d+D)?)(?:T(\d+H)?(\d+M)?(\d+(?:\.\d+)?S)?)?$`)
+
+func ISO8601Duration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected a string value for %q", k)}
+	}
+
+	// The regex permits designators without any components, e.g. `P` or `P1DT`, so these are rejected separately
+	if v == "P" || strings.HasSuffix(v, "T") || !iso8601DurationRegex.MatchString(v) {
+		return nil, []error{fmt.Errorf("expected %q to be an ISO 8601 duration (e.g. `PT40M`), got %q", k, v)}
+	}
+
+	return
+}
+
+// IsPositiveDuration validates that a string is a positive duration which can be parsed by time.ParseDuration, e.g. `17520h`
+func IsPositiveDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected a string value for %q", k)}
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a duration (e.g. `17520h`), got %q", k, v)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("expected %q to be a positive duration, got %q", k, v)}
+	}
+
+	return
+}
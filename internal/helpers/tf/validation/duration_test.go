@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validation
+
+import (
+	"testing"
+)
+
+func TestISO8601Duration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "PT40M",
+			TestName: "Minutes",
+			ErrCount: 0,
+		},
+		{
+			Value:    "P1DT12H",
+			TestName: "DaysAndHours",
+			ErrCount: 0,
+		},
+		{
+			Value:    "P1W",
+			TestName: "Weeks",
+			ErrCount: 0,
+		},
+		{
+			Value:    "PT0.5S",
+			TestName: "FractionalSeconds",
+			ErrCount: 0,
+		},
+		{
+			Value:    "P",
+			TestName: "Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "P1DT",
+			TestName: "TrailingTimeDesignator",
+			ErrCount: 1,
+		},
+		{
+			Value:    "40m",
+			TestName: "GoDuration",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, errs := ISO8601Duration(tc.Value, "test")
+
+			if len(errs) != tc.ErrCount {
+				t.Fatalf("Expected ISO8601Duration to have %d not %d errors for %q", tc.ErrCount, len(errs), tc.TestName)
+			}
+		})
+	}
+}
+
+func TestIsPositiveDuration(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "17520h",
+			TestName: "Hours",
+			ErrCount: 0,
+		},
+		{
+			Value:    "2400h30m",
+			TestName: "HoursAndMinutes",
+			ErrCount: 0,
+		},
+		{
+			Value:    "0s",
+			TestName: "Zero",
+			ErrCount: 1,
+		},
+		{
+			Value:    "-1h",
+			TestName: "Negative",
+			ErrCount: 1,
+		},
+		{
+			Value:    "P1Y",
+			TestName: "ISO8601Duration",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, errs := IsPositiveDuration(tc.Value, "test")
+
+			if len(errs) != tc.ErrCount {
+				t.Fatalf("Expected IsPositiveDuration to have %d not %d errors for %q", tc.ErrCount, len(errs), tc.TestName)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package validation
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 )
 
@@ -27,3 +28,136 @@ func StringIsEmailAddress(i interface{}, k string) (warnings []string, errors []
 
 	return
 }
+
+// StringIsDeviceFilterRule performs basic syntax validation of a device filter rule, as used by conditional access
+// policies. It checks that quotes and parentheses are balanced and that the rule contains at least one comparison of a
+// device property, e.g. `device.trustType -eq "ServerAD"`. The API performs full validation of the rule.
+func StringIsDeviceFilterRule(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected a string value for %q", k)}
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return nil, []error{fmt.Errorf("value must not be empty for %q", k)}
+	}
+
+	if _, err := ruleExpressionUnquoted(v); err != nil {
+		return nil, []error{fmt.Errorf("%v in %q", err, k)}
+	}
+
+	regExDeviceFilterComparison := regexp.MustCompile(`(?i)device\.[a-z0-9]+\s+-?(eq|ne|startsWith|notStartsWith|endsWith|notEndsWith|contains|notContains|in|notIn)\s+`)
+	if !regExDeviceFilterComparison.MatchString(v) {
+		return nil, []error{fmt.Errorf("value must contain at least one comparison of a device property, e.g. `device.trustType -eq \"ServerAD\"`, for %q", k)}
+	}
+
+	return
+}
+
+// StringIsRoleResourceAction validates that the given string is a resource action for a directory role definition, made
+// up of a namespace followed by an entity and one or more property sets or actions, e.g.
+// `microsoft.directory/applications/basic/update`. The API validates that the action is recognized.
+func StringIsRoleResourceAction(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected a string value for %q", k)}
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return nil, []error{fmt.Errorf("value must not be empty for %q", k)}
+	}
+
+	regExRoleResourceAction := regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(\.[a-zA-Z][a-zA-Z0-9]*)+(/[a-zA-Z0-9][a-zA-Z0-9._-]*){2,}$`)
+	if !regExRoleResourceAction.MatchString(v) {
+		return nil, []error{fmt.Errorf("value must be a resource action in the format `namespace/entity/action`, e.g. `microsoft.directory/applications/create`, for %q", k)}
+	}
+
+	return
+}
+
+// StringIsMembershipRule performs basic syntax validation of a dynamic membership rule for a group. It checks that
+// quotes, parentheses and brackets are balanced, that all operators are recognized, and that the rule refers to either
+// `user.` or `device.` properties, but not both. Empty values are permitted. The API performs full validation of the rule.
+func StringIsMembershipRule(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected a string value for %q", k)}
+	}
+
+	if strings.TrimSpace(v) == "" {
+		return
+	}
+
+	// Rules for direct reports have a fixed format which does not use operators
+	if regexp.MustCompile(`(?i)^\s*direct reports for "[0-9a-f-]+"\s*$`).MatchString(v) {
+		return
+	}
+
+	unquoted, err := ruleExpressionUnquoted(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%v in %q", err, k)}
+	}
+
+	knownOperators := []string{
+		"-all", "-and", "-any", "-contains", "-eq", "-ge", "-gt", "-in", "-le", "-lt", "-match", "-minus", "-ne", "-not",
+		"-notcontains", "-notin", "-notmatch", "-notstartswith", "-or", "-plus", "-startswith",
+	}
+	for _, operator := range regexp.MustCompile(`(^|[\s(])(-[A-Za-z]+)`).FindAllStringSubmatch(unquoted, -1) {
+		if !slices.Contains(knownOperators, strings.ToLower(operator[2])) {
+			return nil, []error{fmt.Errorf("unrecognized operator %q in %q", operator[2], k)}
+		}
+	}
+
+	hasUserProperty := regexp.MustCompile(`(?i)(^|[^a-z0-9.])user\.[a-z]`).MatchString(unquoted)
+	hasDeviceProperty := regexp.MustCompile(`(?i)(^|[^a-z0-9.])device\.[a-z]`).MatchString(unquoted)
+	if !hasUserProperty && !hasDeviceProperty {
+		return nil, []error{fmt.Errorf("value must refer to at least one `user.` or `device.` property, e.g. `user.department -eq \"Sales\"`, for %q", k)}
+	}
+	if hasUserProperty && hasDeviceProperty {
+		return nil, []error{fmt.Errorf("value cannot refer to both `user.` and `device.` properties for %q", k)}
+	}
+
+	return
+}
+
+// ruleExpressionUnquoted checks that quotes, parentheses and brackets are balanced in a rule expression, and returns the
+// expression with the contents of any quoted strings removed.
+func ruleExpressionUnquoted(v string) (string, error) {
+	var result strings.Builder
+	var stack []rune
+	var quote rune
+	for _, c := range v {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+				result.WriteRune(c)
+			}
+			continue
+		case c == '"' || c == '\'':
+			quote = c
+			result.WriteRune(c)
+			continue
+		case c == '(' || c == '[':
+			stack = append(stack, c)
+		case c == ')' || c == ']':
+			opening := '('
+			if c == ']' {
+				opening = '['
+			}
+			if len(stack) == 0 || stack[len(stack)-1] != opening {
+				return "", fmt.Errorf("unexpected %q", c)
+			}
+			stack = stack[:len(stack)-1]
+		}
+		result.WriteRune(c)
+	}
+	if quote != 0 {
+		return "", fmt.Errorf("unterminated string")
+	}
+	if len(stack) > 0 {
+		return "", fmt.Errorf("unbalanced parentheses or brackets")
+	}
+
+	return result.String(), nil
+}
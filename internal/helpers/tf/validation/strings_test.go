@@ -40,3 +40,220 @@ func TestStringIsEmailAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestStringIsDeviceFilterRule(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    `device.trustType -eq "ServerAD"`,
+			TestName: "Valid_SingleComparison",
+			ErrCount: 0,
+		},
+		{
+			Value:    `(device.isCompliant -eq True) -or (device.trustType -in ["ServerAD", "AzureAD"])`,
+			TestName: "Valid_Grouped",
+			ErrCount: 0,
+		},
+		{
+			Value:    `device.displayName -contains "(test"`,
+			TestName: "Valid_ParenthesisInString",
+			ErrCount: 0,
+		},
+		{
+			Value:    `device.operatingSystem eq "Doors"`,
+			TestName: "Valid_OperatorWithoutHyphen",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    `(device.trustType -eq "ServerAD"`,
+			TestName: "Invalid_UnbalancedParentheses",
+			ErrCount: 1,
+		},
+		{
+			Value:    `device.trustType -eq "ServerAD)`,
+			TestName: "Invalid_UnterminatedString",
+			ErrCount: 1,
+		},
+		{
+			Value:    `device.trustType == "ServerAD"`,
+			TestName: "Invalid_NoComparison",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, errs := StringIsDeviceFilterRule(tc.Value, "test")
+
+			if len(errs) != tc.ErrCount {
+				t.Fatalf("Expected StringIsDeviceFilterRule to have %d not %d errors for %q", tc.ErrCount, len(errs), tc.TestName)
+			}
+		})
+	}
+}
+
+func TestStringIsMembershipRule(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    `user.department -eq "Sales"`,
+			TestName: "Valid_SingleComparison",
+			ErrCount: 0,
+		},
+		{
+			Value:    `(user.department -eq "Sales") -and (user.country -in ["GB", "IE"]) -and -not (user.jobTitle -startsWith "Temp")`,
+			TestName: "Valid_Grouped",
+			ErrCount: 0,
+		},
+		{
+			Value:    `user.assignedPlans -any (assignedPlan.servicePlanId -eq "efb87545-963c-4e0d-99df-69c6916d9eb0" -and assignedPlan.capabilityStatus -eq "Enabled")`,
+			TestName: "Valid_MultiValuedProperty",
+			ErrCount: 0,
+		},
+		{
+			Value:    `device.devicePhysicalIds -any (_ -startsWith "[ZTDId]")`,
+			TestName: "Valid_Device",
+			ErrCount: 0,
+		},
+		{
+			Value:    `user.employeeHireDate -le system.now -plus p1d`,
+			TestName: "Valid_DateComparisonPlus",
+			ErrCount: 0,
+		},
+		{
+			Value:    `user.employeeHireDate -ge system.now -minus p30d`,
+			TestName: "Valid_DateComparisonMinus",
+			ErrCount: 0,
+		},
+		{
+			Value:    `(user.employeeHireDate -gt system.now -minus p7d) -and (user.employeeHireDate -lt system.now)`,
+			TestName: "Valid_DateComparisonStrict",
+			ErrCount: 0,
+		},
+		{
+			Value:    `user.displayName -contains "(-foo"`,
+			TestName: "Valid_OperatorInString",
+			ErrCount: 0,
+		},
+		{
+			Value:    `Direct Reports for "3a8a7ee5-f8e7-4a3d-86c7-4d3d6fbe7b61"`,
+			TestName: "Valid_DirectReports",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Valid_Empty",
+			ErrCount: 0,
+		},
+		{
+			Value:    `(user.department -eq "Sales"`,
+			TestName: "Invalid_UnbalancedParentheses",
+			ErrCount: 1,
+		},
+		{
+			Value:    `user.country -in ["GB", "IE")`,
+			TestName: "Invalid_MismatchedBrackets",
+			ErrCount: 1,
+		},
+		{
+			Value:    `user.department -eq "Sales`,
+			TestName: "Invalid_UnterminatedString",
+			ErrCount: 1,
+		},
+		{
+			Value:    `user.department -equals "Sales"`,
+			TestName: "Invalid_UnknownOperator",
+			ErrCount: 1,
+		},
+		{
+			Value:    `department -eq "Sales"`,
+			TestName: "Invalid_NoProperty",
+			ErrCount: 1,
+		},
+		{
+			Value:    `(user.department -eq "Sales") -or (device.deviceOSType -eq "Windows")`,
+			TestName: "Invalid_MixedProperties",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, errs := StringIsMembershipRule(tc.Value, "test")
+
+			if len(errs) != tc.ErrCount {
+				t.Fatalf("Expected StringIsMembershipRule to have %d not %d errors for %q", tc.ErrCount, len(errs), tc.TestName)
+			}
+		})
+	}
+}
+
+func TestStringIsRoleResourceAction(t *testing.T) {
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    "microsoft.directory/applications/create",
+			TestName: "Valid_Action",
+			ErrCount: 0,
+		},
+		{
+			Value:    "microsoft.directory/applications.myOrganization/allProperties/read",
+			TestName: "Valid_PropertySet",
+			ErrCount: 0,
+		},
+		{
+			Value:    "microsoft.directory/servicePrincipals/managePermissionGrantsForAll.microsoft-company-admin",
+			TestName: "Valid_PermissionGrantPolicy",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    "applications/create",
+			TestName: "Invalid_NoNamespace",
+			ErrCount: 1,
+		},
+		{
+			Value:    "microsoft.directory/applications",
+			TestName: "Invalid_NoAction",
+			ErrCount: 1,
+		},
+		{
+			Value:    "microsoft.directory/applications/basic/update ",
+			TestName: "Invalid_TrailingSpace",
+			ErrCount: 1,
+		},
+		{
+			Value:    "microsoft.directory//applications/create",
+			TestName: "Invalid_EmptySegment",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			_, errs := StringIsRoleResourceAction(tc.Value, "test")
+
+			if len(errs) != tc.ErrCount {
+				t.Fatalf("Expected StringIsRoleResourceAction to have %d not %d errors for %q", tc.ErrCount, len(errs), tc.TestName)
+			}
+		})
+	}
+}
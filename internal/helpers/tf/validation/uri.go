@@ -57,6 +57,34 @@ func IsRedirectUriFunc(urnAllowed bool, allowAllSchemes bool) pluginsdk.SchemaVa
 	}
 }
 
+// IsSpaRedirectUri validates a redirect URI for a single-page application, which must use the https scheme unless it
+// refers to the local loopback address
+func IsSpaRedirectUri(i interface{}, k string) (warnings []string, errors []error) {
+	warnings, errors = IsRedirectUriFunc(false, false)(i, k)
+	if len(errors) > 0 {
+		return
+	}
+
+	u, err := url.Parse(i.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("URI is in an invalid format for %q", k))
+		return
+	}
+
+	switch u.Scheme {
+	case "https":
+		return
+	case "http":
+		switch u.Hostname() {
+		case "localhost", "127.0.0.1", "::1":
+			return
+		}
+	}
+
+	errors = append(errors, fmt.Errorf("single-page application redirect URIs must use the https scheme, or http with localhost, for %q", k))
+	return
+}
+
 func IsUriFunc(validUriSchemes []string, urnAllowed bool, allowTrailingSlash bool, forceTrailingSlash bool) pluginsdk.SchemaValidateFunc {
 	return func(i interface{}, k string) ([]string, []error) {
 		v, ok := i.(string)
@@ -158,6 +158,63 @@ func TestIsAppURI(t *testing.T) {
 	}
 }
 
+func TestIsSpaRedirectUri(t *testing.T) {
+	cases := []struct {
+		Url    string
+		Errors int
+	}{
+		{
+			Url:    "",
+			Errors: 1,
+		},
+		{
+			Url:    "urn:ietf:wg:oauth:2.0:oob",
+			Errors: 1,
+		},
+		{
+			Url:    "ms-appx-web://www.example.com/",
+			Errors: 1,
+		},
+		{
+			Url:    "http://www.example.com/",
+			Errors: 1,
+		},
+		{
+			Url:    "http://localhost.example.com/",
+			Errors: 1,
+		},
+		{
+			Url:    "https://www.example.com/",
+			Errors: 0,
+		},
+		{
+			Url:    "http://localhost:3000/",
+			Errors: 0,
+		},
+		{
+			Url:    "http://127.0.0.1/callback",
+			Errors: 0,
+		},
+		{
+			Url:    "http://[::1]:8080/",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Url, func(t *testing.T) {
+			warnings, errors := IsSpaRedirectUri(tc.Url, "test")
+
+			if len(warnings) > 0 {
+				t.Fatalf("Expected IsSpaRedirectUri to have 0 not %d warnings for %q", len(warnings), tc.Url)
+			}
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected IsSpaRedirectUri to have %d not %d errors for %q", tc.Errors, len(errors), tc.Url)
+			}
+		})
+	}
+}
+
 func TestIsUriFunc(t *testing.T) {
 	cases := []struct {
 		TestName           string
@@ -4,12 +4,16 @@
 package provider
 
 import (
+	"encoding/asn1"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"strings"
 
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 )
 
@@ -39,6 +43,64 @@ func decodeCertificate(clientCertificate string) ([]byte, error) {
 	return pfx, nil
 }
 
+// getClientCertificate returns the PKCS#12 client certificate bundle, either decoded from the base64 encoded
+// `client_certificate` property, or read from the file specified by `client_certificate_path`
+func getClientCertificate(d *pluginsdk.ResourceData) ([]byte, error) {
+	if encodedCert := d.Get("client_certificate").(string); encodedCert != "" {
+		return decodeCertificate(encodedCert)
+	}
+
+	path := d.Get("client_certificate_path").(string)
+	if path == "" {
+		return nil, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("the Client Certificate file %q does not exist", path)
+		}
+		return nil, fmt.Errorf("reading Client Certificate from file %q: %v", path, err)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("the Client Certificate path %q is a directory, expected a PKCS#12 (.pfx) file", path)
+	}
+
+	pfx, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading Client Certificate from file %q: %v", path, err)
+	}
+
+	if err = validatePkcs12Container(pfx); err != nil {
+		return nil, fmt.Errorf("the Client Certificate file %q does not appear to be a PKCS#12 (.pfx) bundle: %v", path, err)
+	}
+
+	return pfx, nil
+}
+
+// validatePkcs12Container checks that the provided data has the outer structure of a PKCS#12 bundle, as defined in
+// RFC 7292. The bundle is not decrypted, since this requires the password and is performed when authenticating.
+func validatePkcs12Container(pfx []byte) error {
+	var pfxPdu struct {
+		Version  int
+		AuthSafe asn1.RawValue
+		MacData  asn1.RawValue `asn1:"optional"`
+	}
+
+	rest, err := asn1.Unmarshal(pfx, &pfxPdu)
+	if err != nil {
+		return fmt.Errorf("parsing PFX structure: %v", err)
+	}
+	if len(rest) > 0 {
+		return fmt.Errorf("found %d bytes of trailing data", len(rest))
+	}
+	if pfxPdu.Version != 3 {
+		return fmt.Errorf("unsupported PFX version %d", pfxPdu.Version)
+	}
+
+	return nil
+}
+
 func getOidcToken(d *pluginsdk.ResourceData) (*string, error) {
 	idToken := d.Get("oidc_token").(string)
 
@@ -61,6 +123,40 @@ func getOidcToken(d *pluginsdk.ResourceData) (*string, error) {
 	return &idToken, nil
 }
 
+// getOidcRequestUrl returns the URL from which an ID token should be requested, e.g. when running in GitHub Actions
+// where the ACTIONS_ID_TOKEN_REQUEST_URL and ACTIONS_ID_TOKEN_REQUEST_TOKEN environment variables are set. Tokens are
+// requested by the SDK each time an access token is acquired, so that short-lived ID tokens do not expire during long
+// running operations. The SDK requests the audience `api://AzureADTokenExchange` unless one is set in the URL.
+func getOidcRequestUrl(d *pluginsdk.ResourceData) (*string, error) {
+	requestUrl := d.Get("oidc_request_url").(string)
+	requestToken := d.Get("oidc_request_token").(string)
+
+	if !d.Get("use_oidc").(bool) || (requestUrl == "" && requestToken == "") {
+		return &requestUrl, nil
+	}
+
+	if requestUrl == "" {
+		return nil, fmt.Errorf("`oidc_request_token` was specified without `oidc_request_url` - please set `oidc_request_url` or the ARM_OIDC_REQUEST_URL or ACTIONS_ID_TOKEN_REQUEST_URL environment variable")
+	}
+	if requestToken == "" {
+		return nil, fmt.Errorf("`oidc_request_url` was specified without `oidc_request_token` - please set `oidc_request_token` or the ARM_OIDC_REQUEST_TOKEN or ACTIONS_ID_TOKEN_REQUEST_TOKEN environment variable")
+	}
+
+	if audience := d.Get("oidc_request_audience").(string); audience != "" {
+		u, err := url.Parse(requestUrl)
+		if err != nil {
+			return nil, fmt.Errorf("parsing OIDC request URL %q: %v", requestUrl, err)
+		}
+
+		query := u.Query()
+		query.Set("audience", audience)
+		u.RawQuery = query.Encode()
+		requestUrl = u.String()
+	}
+
+	return &requestUrl, nil
+}
+
 func getClientId(d *pluginsdk.ResourceData) (*string, error) {
 	clientId := strings.TrimSpace(d.Get("client_id").(string))
 
@@ -80,6 +176,17 @@ func getClientId(d *pluginsdk.ResourceData) (*string, error) {
 		clientId = fileClientId
 	}
 
+	// A user-assigned Managed Identity is specified separately to the application client ID, and is used in its place
+	// when acquiring tokens using Managed Identity
+	if managedIdentityId := strings.TrimSpace(d.Get("managed_identity_id").(string)); managedIdentityId != "" {
+		if !d.Get("use_msi").(bool) {
+			return nil, fmt.Errorf("`managed_identity_id` was specified but Managed Identity authentication is not enabled - please set `use_msi` or the ARM_USE_MSI environment variable")
+		}
+
+		logEntry("[DEBUG] Using user-assigned Managed Identity with Client ID %q", managedIdentityId)
+		clientId = managedIdentityId
+	}
+
 	return &clientId, nil
 }
 
@@ -102,10 +209,16 @@ func getClientSecret(d *pluginsdk.ResourceData) (*string, error) {
 		clientSecret = fileSecret
 	}
 
+	// `client_secret` is already defaulted from ARM_CLIENT_SECRET, so only fall back to AZURE_CLIENT_SECRET, and only
+	// when no other authentication method has been enabled, since this variable is often set for other tools
+	if clientSecret == "" && !d.Get("use_msi").(bool) && !d.Get("use_oidc").(bool) && !d.Get("use_aks_workload_identity").(bool) {
+		clientSecret = strings.TrimSpace(os.Getenv("AZURE_CLIENT_SECRET"))
+	}
+
 	return &clientSecret, nil
 }
 
-func getTenantId(d *pluginsdk.ResourceData) (*string, error) {
+func getTenantId(d *pluginsdk.ResourceData, idToken string) (*string, error) {
 	tenantId := strings.TrimSpace(d.Get("tenant_id").(string))
 
 	if d.Get("use_aks_workload_identity").(bool) && os.Getenv("AZURE_TENANT_ID") != "" {
@@ -116,5 +229,51 @@ func getTenantId(d *pluginsdk.ResourceData) (*string, error) {
 		tenantId = aksTenantId
 	}
 
+	// Tokens issued by Azure Active Directory include the tenant ID in the `tid` claim, which can be used when no
+	// tenant ID has otherwise been supplied. Tokens from other identity providers, such as GitHub Actions, do not
+	// include this claim, and tokens requested using `oidc_request_url` are only obtained later by the SDK, so a
+	// tenant ID must always be supplied in those cases.
+	if d.Get("use_oidc").(bool) && idToken != "" {
+		if oidcTenantId := tenantIdFromOidcToken(idToken); oidcTenantId != "" {
+			if tenantId != "" && !strings.EqualFold(tenantId, oidcTenantId) {
+				return nil, fmt.Errorf("mismatch between supplied Tenant ID and the `tid` claim of the OIDC token - please remove, ensure they match, or use a token issued for the correct tenant")
+			}
+			if tenantId == "" {
+				logEntry("[DEBUG] Using Tenant ID %q from the `tid` claim of the OIDC token", oidcTenantId)
+				tenantId = oidcTenantId
+			}
+		}
+	}
+
 	return &tenantId, nil
 }
+
+// tenantIdFromOidcToken returns the value of the `tid` claim from the provided JWT, or an empty string when the token
+// cannot be parsed or does not contain a valid tenant ID. The token signature is not verified, since the token is only
+// inspected here and is validated by Azure Active Directory when exchanged for an access token.
+func tenantIdFromOidcToken(idToken string) string {
+	parts := strings.Split(strings.TrimSpace(idToken), ".")
+	if len(parts) != 3 {
+		return ""
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		logEntry("[DEBUG] Unable to decode OIDC token claims: %v", err)
+		return ""
+	}
+
+	var claims struct {
+		TenantId string `json:"tid"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		logEntry("[DEBUG] Unable to unmarshal OIDC token claims: %v", err)
+		return ""
+	}
+
+	if _, err = uuid.ParseUUID(claims.TenantId); err != nil {
+		return ""
+	}
+
+	return claims.TenantId
+}
@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+func testOidcEnvironment(t *testing.T) {
+	for _, v := range []string{"ARM_OIDC_TOKEN", "ARM_OIDC_TOKEN_FILE_PATH", "ARM_OIDC_REQUEST_TOKEN", "ARM_OIDC_REQUEST_URL", "ARM_OIDC_REQUEST_AUDIENCE", "ACTIONS_ID_TOKEN_REQUEST_TOKEN", "ACTIONS_ID_TOKEN_REQUEST_URL"} {
+		t.Setenv(v, "")
+	}
+}
+
+func TestGetOidcRequestUrl(t *testing.T) {
+	testOidcEnvironment(t)
+
+	const requestUrl = "https://token.actions.githubusercontent.com/_apis/token?api-version=2.0"
+
+	cases := []struct {
+		TestName string
+		Config   map[string]interface{}
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "DefaultAudience",
+			Config: map[string]interface{}{
+				"use_oidc":           true,
+				"oidc_request_url":   requestUrl,
+				"oidc_request_token": "request-token",
+			},
+			Expected: requestUrl,
+		},
+		{
+			TestName: "CustomAudience",
+			Config: map[string]interface{}{
+				"use_oidc":              true,
+				"oidc_request_url":      requestUrl,
+				"oidc_request_token":    "request-token",
+				"oidc_request_audience": "api://AzureADTokenExchangeUSGov",
+			},
+			Expected: "https://token.actions.githubusercontent.com/_apis/token?api-version=2.0&audience=api%3A%2F%2FAzureADTokenExchangeUSGov",
+		},
+		{
+			TestName: "OidcDisabled",
+			Config: map[string]interface{}{
+				"oidc_request_url": requestUrl,
+			},
+			Expected: requestUrl,
+		},
+		{
+			TestName: "NotConfigured",
+			Config: map[string]interface{}{
+				"use_oidc": true,
+			},
+			Expected: "",
+		},
+		{
+			TestName: "MissingRequestToken",
+			Config: map[string]interface{}{
+				"use_oidc":         true,
+				"oidc_request_url": requestUrl,
+			},
+			Error: true,
+		},
+		{
+			TestName: "MissingRequestUrl",
+			Config: map[string]interface{}{
+				"use_oidc":           true,
+				"oidc_request_token": "request-token",
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, AzureADProvider().Schema, tc.Config)
+
+			actual, err := getOidcRequestUrl(d)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if *actual != tc.Expected {
+				t.Fatalf("expected request URL %q, got %q", tc.Expected, *actual)
+			}
+		})
+	}
+}
+
+func TestGetClientSecret(t *testing.T) {
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(secretFile, []byte("file-secret\n"), 0600); err != nil {
+		t.Fatalf("writing secret file: %+v", err)
+	}
+
+	cases := []struct {
+		TestName          string
+		Config            map[string]interface{}
+		ArmClientSecret   string
+		AzureClientSecret string
+		Expected          string
+		Error             bool
+	}{
+		{
+			TestName: "Config",
+			Config: map[string]interface{}{
+				"client_secret": "config-secret",
+			},
+			AzureClientSecret: "azure-secret",
+			Expected:          "config-secret",
+		},
+		{
+			TestName: "File",
+			Config: map[string]interface{}{
+				"client_secret_file_path": secretFile,
+			},
+			AzureClientSecret: "azure-secret",
+			Expected:          "file-secret",
+		},
+		{
+			TestName: "ConfigAndFileMismatch",
+			Config: map[string]interface{}{
+				"client_secret":           "config-secret",
+				"client_secret_file_path": secretFile,
+			},
+			Error: true,
+		},
+		{
+			TestName:        "ArmEnvironment",
+			Config:          map[string]interface{}{},
+			ArmClientSecret: "arm-secret",
+			Expected:        "arm-secret",
+		},
+		{
+			TestName:          "AzureEnvironment",
+			Config:            map[string]interface{}{},
+			AzureClientSecret: "azure-secret",
+			Expected:          "azure-secret",
+		},
+		{
+			TestName:          "ArmEnvironmentPreferred",
+			Config:            map[string]interface{}{},
+			ArmClientSecret:   "arm-secret",
+			AzureClientSecret: "azure-secret",
+			Expected:          "arm-secret",
+		},
+		{
+			TestName: "AzureEnvironmentWithManagedIdentity",
+			Config: map[string]interface{}{
+				"use_msi": true,
+			},
+			AzureClientSecret: "azure-secret",
+			Expected:          "",
+		},
+		{
+			TestName: "AzureEnvironmentWithOidc",
+			Config: map[string]interface{}{
+				"use_oidc": true,
+			},
+			AzureClientSecret: "azure-secret",
+			Expected:          "",
+		},
+		{
+			TestName: "AzureEnvironmentWithAksWorkloadIdentity",
+			Config: map[string]interface{}{
+				"use_aks_workload_identity": true,
+			},
+			AzureClientSecret: "azure-secret",
+			Expected:          "",
+		},
+		{
+			TestName: "NotConfigured",
+			Config:   map[string]interface{}{},
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Setenv("ARM_CLIENT_SECRET", tc.ArmClientSecret)
+			t.Setenv("ARM_CLIENT_SECRET_FILE_PATH", "")
+			t.Setenv("AZURE_CLIENT_SECRET", tc.AzureClientSecret)
+
+			d := schema.TestResourceDataRaw(t, AzureADProvider().Schema, tc.Config)
+
+			secret, err := getClientSecret(d)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if *secret != tc.Expected {
+				t.Fatalf("expected secret %q, got %q", tc.Expected, *secret)
+			}
+		})
+	}
+}
+
+func TestGetClientId_managedIdentity(t *testing.T) {
+	t.Setenv("ARM_CLIENT_ID", "")
+	t.Setenv("ARM_CLIENT_ID_FILE_PATH", "")
+	t.Setenv("ARM_MANAGED_IDENTITY_ID", "")
+	t.Setenv("ARM_USE_MSI", "")
+
+	cases := []struct {
+		TestName string
+		Config   map[string]interface{}
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "ClientIdOnly",
+			Config: map[string]interface{}{
+				"client_id": "11111111-1111-1111-1111-111111111111",
+				"use_msi":   true,
+			},
+			Expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			TestName: "ManagedIdentityId",
+			Config: map[string]interface{}{
+				"client_id":           "11111111-1111-1111-1111-111111111111",
+				"managed_identity_id": "22222222-2222-2222-2222-222222222222",
+				"use_msi":             true,
+			},
+			Expected: "22222222-2222-2222-2222-222222222222",
+		},
+		{
+			TestName: "ManagedIdentityIdWithoutMsi",
+			Config: map[string]interface{}{
+				"managed_identity_id": "22222222-2222-2222-2222-222222222222",
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, AzureADProvider().Schema, tc.Config)
+
+			clientId, err := getClientId(d)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if *clientId != tc.Expected {
+				t.Fatalf("expected client ID %q, got %q", tc.Expected, *clientId)
+			}
+		})
+	}
+}
+
+func testPkcs12Bundle(t *testing.T) []byte {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating key: %+v", err)
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %+v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %+v", err)
+	}
+
+	pfx, err := pkcs12.Modern.Encode(key, cert, nil, "password")
+	if err != nil {
+		t.Fatalf("encoding PKCS#12 bundle: %+v", err)
+	}
+
+	return pfx
+}
+
+func TestGetClientCertificate(t *testing.T) {
+	pfx := testPkcs12Bundle(t)
+
+	dir := t.TempDir()
+	pfxFile := filepath.Join(dir, "client.pfx")
+	if err := os.WriteFile(pfxFile, pfx, 0600); err != nil {
+		t.Fatalf("writing certificate file: %+v", err)
+	}
+	pemFile := filepath.Join(dir, "client.pem")
+	if err := os.WriteFile(pemFile, []byte("-----BEGIN CERTIFICATE-----\nnot a pfx\n-----END CERTIFICATE-----\n"), 0600); err != nil {
+		t.Fatalf("writing certificate file: %+v", err)
+	}
+
+	cases := []struct {
+		TestName string
+		Config   map[string]interface{}
+		Expected []byte
+		Error    bool
+	}{
+		{
+			TestName: "None",
+			Config:   map[string]interface{}{},
+		},
+		{
+			TestName: "Inline",
+			Config: map[string]interface{}{
+				"client_certificate": base64.StdEncoding.EncodeToString(pfx),
+			},
+			Expected: pfx,
+		},
+		{
+			TestName: "Path",
+			Config: map[string]interface{}{
+				"client_certificate_path": pfxFile,
+			},
+			Expected: pfx,
+		},
+		{
+			TestName: "PathDoesNotExist",
+			Config: map[string]interface{}{
+				"client_certificate_path": filepath.Join(dir, "missing.pfx"),
+			},
+			Error: true,
+		},
+		{
+			TestName: "PathIsDirectory",
+			Config: map[string]interface{}{
+				"client_certificate_path": dir,
+			},
+			Error: true,
+		},
+		{
+			TestName: "PathNotPkcs12",
+			Config: map[string]interface{}{
+				"client_certificate_path": pemFile,
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Setenv("ARM_CLIENT_CERTIFICATE", "")
+			t.Setenv("ARM_CLIENT_CERTIFICATE_PATH", "")
+
+			d := schema.TestResourceDataRaw(t, AzureADProvider().Schema, tc.Config)
+
+			certData, err := getClientCertificate(d)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if !bytes.Equal(certData, tc.Expected) {
+				t.Fatalf("unexpected certificate data")
+			}
+		})
+	}
+}
+
+func testOidcTokenWithClaims(claims string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	return header + "." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".signature"
+}
+
+func TestGetTenantId_oidcToken(t *testing.T) {
+	t.Setenv("ARM_TENANT_ID", "")
+	t.Setenv("ARM_USE_OIDC", "")
+	t.Setenv("ARM_USE_AKS_WORKLOAD_IDENTITY", "")
+
+	tokenWithTenant := testOidcTokenWithClaims(`{"aud":"api://AzureADTokenExchange","tid":"11111111-1111-1111-1111-111111111111"}`)
+	tokenWithoutTenant := testOidcTokenWithClaims(`{"aud":"api://AzureADTokenExchange","sub":"repo:example/example:ref:refs/heads/main"}`)
+
+	cases := []struct {
+		TestName string
+		Config   map[string]interface{}
+		IdToken  string
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "FromToken",
+			Config: map[string]interface{}{
+				"use_oidc": true,
+			},
+			IdToken:  tokenWithTenant,
+			Expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			TestName: "ConfigMatchesToken",
+			Config: map[string]interface{}{
+				"tenant_id": "11111111-1111-1111-1111-111111111111",
+				"use_oidc":  true,
+			},
+			IdToken:  tokenWithTenant,
+			Expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			TestName: "ConfigMismatchesToken",
+			Config: map[string]interface{}{
+				"tenant_id": "22222222-2222-2222-2222-222222222222",
+				"use_oidc":  true,
+			},
+			IdToken: tokenWithTenant,
+			Error:   true,
+		},
+		{
+			TestName: "TokenWithoutTenant",
+			Config: map[string]interface{}{
+				"tenant_id": "22222222-2222-2222-2222-222222222222",
+				"use_oidc":  true,
+			},
+			IdToken:  tokenWithoutTenant,
+			Expected: "22222222-2222-2222-2222-222222222222",
+		},
+		{
+			TestName: "InvalidToken",
+			Config: map[string]interface{}{
+				"use_oidc": true,
+			},
+			IdToken:  "not a token",
+			Expected: "",
+		},
+		{
+			TestName: "OidcDisabled",
+			Config:   map[string]interface{}{},
+			IdToken:  tokenWithTenant,
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, AzureADProvider().Schema, tc.Config)
+
+			tenantId, err := getTenantId(d, tc.IdToken)
+			if tc.Error {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if *tenantId != tc.Expected {
+				t.Fatalf("expected tenant ID %q, got %q", tc.Expected, *tenantId)
+			}
+		})
+	}
+}
@@ -203,6 +203,13 @@ func AzureADProvider() *schema.Provider {
 				Description: "The URL for the OIDC provider from which to request an ID token. For use when authenticating as a Service Principal using OpenID Connect.",
 			},
 
+			"oidc_request_audience": {
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+				DefaultFunc: pluginsdk.EnvDefaultFunc("ARM_OIDC_REQUEST_AUDIENCE", ""),
+				Description: "The audience to request when obtaining an ID token from the OIDC provider. Defaults to `api://AzureADTokenExchange`. For use when authenticating as a Service Principal using OpenID Connect.",
+			},
+
 			// Azure AKS Workload Identity fields
 			"use_aks_workload_identity": {
 				Type:        schema.TypeBool,
@@ -227,6 +234,14 @@ func AzureADProvider() *schema.Provider {
 				Description: "Allow Managed Identity to be used for Authentication",
 			},
 
+			"managed_identity_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.Any(validation.IsUUID, validation.StringIsEmpty),
+				DefaultFunc:  pluginsdk.EnvDefaultFunc("ARM_MANAGED_IDENTITY_ID", ""),
+				Description:  "The Client ID of a user-assigned Managed Identity to use for Authentication, when more than one Managed Identity is assigned. This is used instead of the `client_id` when authenticating using Managed Identity",
+			},
+
 			"msi_endpoint": {
 				Type:        pluginsdk.TypeString,
 				Optional:    true,
@@ -262,13 +277,9 @@ func AzureADProvider() *schema.Provider {
 
 func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 	return func(ctx context.Context, d *pluginsdk.ResourceData) (interface{}, pluginsdk.Diagnostics) {
-		var certData []byte
-		if encodedCert := d.Get("client_certificate").(string); encodedCert != "" {
-			var err error
-			certData, err = decodeCertificate(encodedCert)
-			if err != nil {
-				return nil, pluginsdk.DiagFromErr(err)
-			}
+		certData, err := getClientCertificate(d)
+		if err != nil {
+			return nil, pluginsdk.DiagFromErr(err)
 		}
 
 		idToken, err := getOidcToken(d)
@@ -276,6 +287,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
+		oidcRequestUrl, err := getOidcRequestUrl(d)
+		if err != nil {
+			return nil, pluginsdk.DiagFromErr(err)
+		}
+
 		clientSecret, err := getClientSecret(d)
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
@@ -286,7 +302,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, *idToken)
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -320,6 +336,10 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			enableAzureCli        = d.Get("use_cli").(bool)
 			enableManagedIdentity = d.Get("use_msi").(bool)
 			enableOidc            = d.Get("use_oidc").(bool) || d.Get("use_aks_workload_identity").(bool)
+
+			// When a user-assigned Managed Identity has been specified, the client ID refers to that identity and
+			// so cannot be used with the other service principal authentication methods
+			enableServicePrincipal = d.Get("managed_identity_id").(string) == ""
 		)
 
 		authConfig := &auth.Credentials{
@@ -333,17 +353,17 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			ClientSecret:              *clientSecret,
 
 			OIDCAssertionToken:          *idToken,
-			GitHubOIDCTokenRequestURL:   d.Get("oidc_request_url").(string),
+			GitHubOIDCTokenRequestURL:   *oidcRequestUrl,
 			GitHubOIDCTokenRequestToken: d.Get("oidc_request_token").(string),
 
 			CustomManagedIdentityEndpoint: d.Get("msi_endpoint").(string),
 
 			EnableAuthenticatingUsingAzureCLI:          enableAzureCli,
-			EnableAuthenticatingUsingClientCertificate: true,
-			EnableAuthenticatingUsingClientSecret:      true,
+			EnableAuthenticatingUsingClientCertificate: enableServicePrincipal,
+			EnableAuthenticatingUsingClientSecret:      enableServicePrincipal,
 			EnableAuthenticatingUsingManagedIdentity:   enableManagedIdentity,
-			EnableAuthenticationUsingGitHubOIDC:        enableOidc,
-			EnableAuthenticationUsingOIDC:              enableOidc,
+			EnableAuthenticationUsingGitHubOIDC:        enableServicePrincipal && enableOidc,
+			EnableAuthenticationUsingOIDC:              enableServicePrincipal && enableOidc,
 		}
 
 		// only one pid can be interpreted currently
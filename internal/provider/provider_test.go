@@ -43,7 +43,7 @@ func TestAccProvider_cliAuth(t *testing.T) {
 			t.Fatalf("configuring environment %q: %v", envName, err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, "")
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -90,7 +90,7 @@ func TestAccProvider_clientCertificateAuth(t *testing.T) {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, "")
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -149,7 +149,7 @@ func TestAccProvider_clientCertificateInlineAuth(t *testing.T) {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, "")
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -220,7 +220,7 @@ func testAccProvider_clientSecretAuthFromEnvironment(t *testing.T) {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, "")
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -285,7 +285,7 @@ func testAccProvider_clientSecretAuthFromFiles(t *testing.T) {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, "")
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -351,7 +351,7 @@ func testAccProvider_genericOidcAuthFromEnvironment(t *testing.T) {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, *idToken)
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -412,7 +412,7 @@ func testAccProvider_genericOidcAuthFromFiles(t *testing.T) {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, *idToken)
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -467,7 +467,7 @@ func TestAccProvider_githubOidcAuth(t *testing.T) {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
 
-		tenantId, err := getTenantId(d)
+		tenantId, err := getTenantId(d, "")
 		if err != nil {
 			return nil, pluginsdk.DiagFromErr(err)
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/administrativeunits"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/auditlogs"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/conditionalaccess"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryobjects"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/directoryroles"
@@ -27,6 +28,7 @@ import (
 func SupportedTypedServices() []sdk.TypedServiceRegistration {
 	return []sdk.TypedServiceRegistration{
 		applications.Registration{},
+		auditlogs.Registration{},
 		directoryroles.Registration{},
 		domains.Registration{},
 		policies.Registration{},
@@ -40,6 +42,7 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 		administrativeunits.Registration{},
 		applications.Registration{},
 		approleassignments.Registration{},
+		auditlogs.Registration{},
 		conditionalaccess.Registration{},
 		directoryobjects.Registration{},
 		directoryroles.Registration{},
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package administrativeunits
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/administrativeunit"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/administrativeunitmember"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func administrativeUnitMembersResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: administrativeUnitMembersResourceCreate,
+		ReadContext:   administrativeUnitMembersResourceRead,
+		UpdateContext: administrativeUnitMembersResourceUpdate,
+		DeleteContext: administrativeUnitMembersResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			if _, errs := stable.ValidateDirectoryAdministrativeUnitID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			memberClient := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitMemberClient
+
+			id, err := stable.ParseDirectoryAdministrativeUnitID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+
+			// All existing members are imported, since there is no prior state to indicate which are managed
+			members, err := administrativeUnitListMemberIds(ctx, memberClient, *id)
+			if err != nil {
+				return nil, fmt.Errorf("retrieving members for %s: %v", id, err)
+			}
+			if members == nil {
+				return nil, fmt.Errorf("%s was not found", id)
+			}
+
+			if err = d.Set("members", *members); err != nil {
+				return nil, err
+			}
+			if err = d.Set("exclusive", false); err != nil {
+				return nil, err
+			}
+
+			return []*pluginsdk.ResourceData{d}, nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"administrative_unit_object_id": {
+				Description:      "The object ID of the administrative unit",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+			},
+
+			"members": {
+				Description: "A set of object IDs of members who should be present in this administrative unit. Supported object types are Users or Groups",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Set:         pluginsdk.HashString,
+				Elem: &pluginsdk.Schema{
+					Type:             pluginsdk.TypeString,
+					ValidateDiagFunc: validation.ValidateDiag(validation.IsUUID),
+				},
+			},
+
+			"exclusive": {
+				Description: "Whether to remove members of the administrative unit which are not specified in `members`, including those added outside of Terraform",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func administrativeUnitMembersResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitClient
+
+	id := stable.NewDirectoryAdministrativeUnitID(d.Get("administrative_unit_object_id").(string))
+
+	tf.LockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+	defer tf.UnlockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+
+	resp, err := client.GetAdministrativeUnit(ctx, id, administrativeunit.DefaultGetAdministrativeUnitOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "administrative_unit_object_id", "Administrative unit with object ID %q was not found", id.AdministrativeUnitId)
+		}
+		return tf.ErrorDiagPathF(err, "administrative_unit_object_id", "Retrieving administrative unit with object ID: %q", id.AdministrativeUnitId)
+	}
+
+	if err = administrativeUnitMembersApply(ctx, d, meta, id); err != nil {
+		return tf.ErrorDiagF(err, "Updating members for %s", id)
+	}
+
+	d.SetId(id.ID())
+
+	return administrativeUnitMembersResourceRead(ctx, d, meta)
+}
+
+func administrativeUnitMembersResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	id, err := stable.ParseDirectoryAdministrativeUnitID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Administrative Unit ID")
+	}
+
+	tf.LockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+	defer tf.UnlockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+
+	if err = administrativeUnitMembersApply(ctx, d, meta, *id); err != nil {
+		return tf.ErrorDiagF(err, "Updating members for %s", id)
+	}
+
+	return administrativeUnitMembersResourceRead(ctx, d, meta)
+}
+
+func administrativeUnitMembersResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	memberClient := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitMemberClient
+
+	id, err := stable.ParseDirectoryAdministrativeUnitID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Administrative Unit ID")
+	}
+
+	members, err := administrativeUnitListMemberIds(ctx, memberClient, *id)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving members for %s", id)
+	}
+	if members == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	known := tf.ExpandStringSlice(d.Get("members").(*pluginsdk.Set).List())
+
+	tf.Set(d, "administrative_unit_object_id", id.AdministrativeUnitId)
+	tf.Set(d, "members", administrativeUnitManagedMembers(*members, known, d.Get("exclusive").(bool)))
+
+	return nil
+}
+
+func administrativeUnitMembersResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	memberClient := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitMemberClient
+
+	id, err := stable.ParseDirectoryAdministrativeUnitID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Administrative Unit ID")
+	}
+
+	tf.LockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+	defer tf.UnlockByName(administrativeUnitResourceName, id.AdministrativeUnitId)
+
+	existing, err := administrativeUnitListMemberIds(ctx, memberClient, *id)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving members for %s", id)
+	}
+	if existing == nil {
+		return nil
+	}
+
+	// Only the members known to Terraform are removed, regardless of the `exclusive` setting
+	_, membersForRemoval := administrativeUnitMembersChanges(*existing, nil, tf.ExpandStringSlice(d.Get("members").(*pluginsdk.Set).List()), false)
+
+	for _, memberId := range membersForRemoval {
+		if resp, err := memberClient.RemoveAdministrativeUnitMemberRef(ctx, stable.NewDirectoryAdministrativeUnitIdMemberID(id.AdministrativeUnitId, memberId), administrativeunitmember.DefaultRemoveAdministrativeUnitMemberRefOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Removing member %q from %s", memberId, id)
+		}
+	}
+
+	if err = administrativeUnitMembersWait(ctx, memberClient, *id, nil, membersForRemoval); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of members from %s", id)
+	}
+
+	return nil
+}
+
+// administrativeUnitMembersApply adds and removes members of the administrative unit so that its membership reflects
+// the configuration. The caller is expected to hold the lock for the administrative unit.
+func administrativeUnitMembersApply(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id stable.DirectoryAdministrativeUnitId) error {
+	client := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitClient
+	memberClient := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitMemberClient
+
+	existing, err := administrativeUnitListMemberIds(ctx, memberClient, id)
+	if err != nil {
+		return fmt.Errorf("retrieving members: %v", err)
+	}
+	if existing == nil {
+		return fmt.Errorf("administrative unit was not found")
+	}
+
+	oldMembers, newMembers := d.GetChange("members")
+	managed := tf.ExpandStringSlice(oldMembers.(*pluginsdk.Set).List())
+	desired := tf.ExpandStringSlice(newMembers.(*pluginsdk.Set).List())
+
+	membersToAdd, membersForRemoval := administrativeUnitMembersChanges(*existing, desired, managed, d.Get("exclusive").(bool))
+
+	for _, memberId := range membersForRemoval {
+		if resp, err := memberClient.RemoveAdministrativeUnitMemberRef(ctx, stable.NewDirectoryAdministrativeUnitIdMemberID(id.AdministrativeUnitId, memberId), administrativeunitmember.DefaultRemoveAdministrativeUnitMemberRefOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("removing member %q: %v", memberId, err)
+		}
+	}
+
+	for _, memberId := range membersToAdd {
+		addMemberProperties := stable.ReferenceCreate{
+			ODataId: pointer.To(client.Client.BaseUri + stable.NewDirectoryObjectID(memberId).ID()),
+		}
+		if _, err = memberClient.AddAdministrativeUnitMemberRef(ctx, id, addMemberProperties, administrativeunitmember.DefaultAddAdministrativeUnitMemberRefOperationOptions()); err != nil {
+			return fmt.Errorf("adding member %q: %v", memberId, err)
+		}
+	}
+
+	if err = administrativeUnitMembersWait(ctx, memberClient, id, membersToAdd, membersForRemoval); err != nil {
+		return fmt.Errorf("waiting for membership changes: %v", err)
+	}
+
+	return nil
+}
+
+// administrativeUnitMembersWait waits for added members to be present, and removed members to be absent, in the
+// administrative unit.
+func administrativeUnitMembersWait(ctx context.Context, client *administrativeunitmember.AdministrativeUnitMemberClient, id stable.DirectoryAdministrativeUnitId, added, removed []string) error {
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	return consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		members, err := administrativeUnitListMemberIds(ctx, client, id)
+		if err != nil {
+			return nil, err
+		}
+		if members == nil {
+			return pointer.To(false), nil
+		}
+
+		return pointer.To(len(tf.Difference(added, *members)) == 0 && len(administrativeUnitManagedMembers(*members, removed, false)) == 0), nil
+	})
+}
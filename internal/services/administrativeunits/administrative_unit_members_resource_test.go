@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package administrativeunits_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/administrativeunitmember"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AdministrativeUnitMembersResource struct{}
+
+func TestAccAdministrativeUnitMembers_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit_members", "test")
+	r := AdministrativeUnitMembersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.oneUser(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrative_unit_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("members.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.twoUsers(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("members.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.oneUser(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("members.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAdministrativeUnitMembers_nonExclusive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_administrative_unit_members", "test")
+	r := AdministrativeUnitMembersResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withExternalMember(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("members.#").HasValue("1"),
+			),
+		},
+		{
+			// The member added outside of this resource should not be removed
+			Config:   r.withExternalMember(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func (r AdministrativeUnitMembersResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.AdministrativeUnits.AdministrativeUnitMemberClient
+
+	id, err := stable.ParseDirectoryAdministrativeUnitID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Administrative Unit ID: %v", err)
+	}
+
+	resp, err := client.ListAdministrativeUnitMembers(ctx, *id, administrativeunitmember.DefaultListAdministrativeUnitMembersOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve members for %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r AdministrativeUnitMembersResource) oneUser(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+%[2]s
+
+resource "azuread_administrative_unit_members" "test" {
+  administrative_unit_object_id = azuread_administrative_unit.test.object_id
+  members                       = [azuread_user.testA.object_id]
+}
+`, AdministrativeUnitResource{}.basic(data), AdministrativeUnitMemberResource{}.templateThreeUsers(data))
+}
+
+func (r AdministrativeUnitMembersResource) twoUsers(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+%[2]s
+
+resource "azuread_administrative_unit_members" "test" {
+  administrative_unit_object_id = azuread_administrative_unit.test.object_id
+  members                       = [azuread_user.testA.object_id, azuread_user.testB.object_id]
+  exclusive                     = true
+}
+`, AdministrativeUnitResource{}.basic(data), AdministrativeUnitMemberResource{}.templateThreeUsers(data))
+}
+
+func (r AdministrativeUnitMembersResource) withExternalMember(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+%[2]s
+
+resource "azuread_administrative_unit_member" "external" {
+  administrative_unit_object_id = azuread_administrative_unit.test.object_id
+  member_object_id              = azuread_user.testC.object_id
+}
+
+resource "azuread_administrative_unit_members" "test" {
+  administrative_unit_object_id = azuread_administrative_unit.test.object_id
+  members                       = [azuread_user.testA.object_id]
+
+  depends_on = [azuread_administrative_unit_member.external]
+}
+`, AdministrativeUnitResource{}.basic(data), AdministrativeUnitMemberResource{}.templateThreeUsers(data))
+}
@@ -82,12 +82,37 @@ func administrativeUnitResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"dynamic_membership": {
+				Description:   "An optional block to configure dynamic membership for the administrative unit. Cannot be used with `members`",
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"members"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"enabled": {
+							Description: "Whether rule processing is `On` (true) or `Paused` (false)",
+							Type:        pluginsdk.TypeBool,
+							Required:    true,
+						},
+
+						"rule": {
+							Description:  "Rule to determine members for a dynamic administrative unit",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.All(validation.StringLenBetween(0, 3072), validation.StringIsMembershipRule),
+						},
+					},
+				},
+			},
+
 			"members": {
-				Description: "A set of object IDs of members who should be present in this administrative unit. Supported object types are Users or Groups",
-				Type:        pluginsdk.TypeSet,
-				Optional:    true,
-				Computed:    true,
-				Set:         pluginsdk.HashString,
+				Description:   "A set of object IDs of members who should be present in this administrative unit. Supported object types are Users or Groups. Cannot be used with `dynamic_membership`",
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"dynamic_membership"},
+				Set:           pluginsdk.HashString,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.IsUUID,
@@ -107,6 +132,14 @@ func administrativeUnitResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"is_member_management_restricted": {
+				Description: "Whether the administrative unit is a restricted management administrative unit, in which case only administrators explicitly assigned roles scoped to the administrative unit can manage its members",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+
 			"object_id": {
 				Description: "The object ID of the administrative unit",
 				Type:        pluginsdk.TypeString,
@@ -146,6 +179,7 @@ func administrativeUnitResourceCustomizeDiff(ctx context.Context, diff *pluginsd
 
 func administrativeUnitResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitClient
+	clientBeta := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitClientBeta
 	memberClient := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitMemberClient
 
 	displayName := d.Get("display_name").(string)
@@ -165,9 +199,12 @@ func administrativeUnitResourceCreate(ctx context.Context, d *pluginsdk.Resource
 		}
 	}
 
-	properties := stable.AdministrativeUnit{
-		DisplayName: nullable.Value(displayName),
-		Visibility:  nullable.Value(administrativeUnitVisibilityPublic),
+	// The beta API is used to create administrative units, since restricted management can only be enabled at
+	// creation time and is not supported by the stable API
+	properties := beta.AdministrativeUnit{
+		DisplayName:                  nullable.Value(displayName),
+		IsMemberManagementRestricted: nullable.Value(d.Get("is_member_management_restricted").(bool)),
+		Visibility:                   nullable.Value(administrativeUnitVisibilityPublic),
 	}
 
 	if v := d.Get("description").(string); v != "" {
@@ -178,7 +215,7 @@ func administrativeUnitResourceCreate(ctx context.Context, d *pluginsdk.Resource
 		properties.Visibility = nullable.Value(administrativeUnitVisibilityHiddenMembership)
 	}
 
-	resp, err := client.CreateAdministrativeUnit(ctx, properties, administrativeunit.DefaultCreateAdministrativeUnitOperationOptions())
+	resp, err := clientBeta.CreateAdministrativeUnit(ctx, properties, administrativeunitBeta.DefaultCreateAdministrativeUnitOperationOptions())
 	if err != nil {
 		return tf.ErrorDiagF(err, "Creating administrative unit %q", displayName)
 	}
@@ -233,6 +270,17 @@ func administrativeUnitResourceCreate(ctx context.Context, d *pluginsdk.Resource
 		return tf.ErrorDiagF(err, "Failed to patch %s after creating", id)
 	}
 
+	// Dynamic membership is only supported by the beta API
+	if v, ok := d.GetOk("dynamic_membership"); ok && len(v.([]interface{})) > 0 {
+		if _, err = clientBeta.UpdateAdministrativeUnit(ctx, beta.NewAdministrativeUnitID(id.AdministrativeUnitId), expandAdministrativeUnitDynamicMembership(v.([]interface{})), administrativeunitBeta.UpdateAdministrativeUnitOperationOptions{
+			RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
+				return response.WasNotFound(resp), nil
+			},
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Configuring dynamic membership for %s", id)
+		}
+	}
+
 	// Add members after the administrative unit is created
 	if v, ok := d.GetOk("members"); ok {
 		for _, memberIdRaw := range v.(*pluginsdk.Set).List() {
@@ -253,6 +301,7 @@ func administrativeUnitResourceCreate(ctx context.Context, d *pluginsdk.Resource
 
 func administrativeUnitResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitClient
+	clientBeta := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitClientBeta
 	memberClient := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitMemberClient
 
 	id, err := stable.ParseDirectoryAdministrativeUnitID(d.Id())
@@ -298,6 +347,13 @@ func administrativeUnitResourceUpdate(ctx context.Context, d *pluginsdk.Resource
 		return tf.ErrorDiagF(err, "Updating %s", id)
 	}
 
+	// Dynamic membership is only supported by the beta API
+	if d.HasChange("dynamic_membership") {
+		if _, err := clientBeta.UpdateAdministrativeUnit(ctx, beta.NewAdministrativeUnitID(id.AdministrativeUnitId), expandAdministrativeUnitDynamicMembership(d.Get("dynamic_membership").([]interface{})), administrativeunitBeta.DefaultUpdateAdministrativeUnitOperationOptions()); err != nil {
+			return tf.ErrorDiagF(err, "Updating dynamic membership for %s", id)
+		}
+	}
+
 	if d.HasChange("members") {
 		membersResp, err := memberClient.ListAdministrativeUnitMembers(ctx, *id, administrativeunitmember.DefaultListAdministrativeUnitMembersOperationOptions())
 		if err != nil {
@@ -336,6 +392,7 @@ func administrativeUnitResourceUpdate(ctx context.Context, d *pluginsdk.Resource
 
 func administrativeUnitResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitClient
+	clientBeta := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitClientBeta
 	memberClient := meta.(*clients.Client).AdministrativeUnits.AdministrativeUnitMemberClient
 
 	id, err := stable.ParseDirectoryAdministrativeUnitID(d.Id())
@@ -361,6 +418,19 @@ func administrativeUnitResourceRead(ctx context.Context, d *pluginsdk.ResourceDa
 	hiddenMembershipEnabled := strings.EqualFold(administrativeUnit.Visibility.GetOrZero(), administrativeUnitVisibilityHiddenMembership)
 	tf.Set(d, "hidden_membership_enabled", hiddenMembershipEnabled)
 
+	// Dynamic membership properties are only returned by the beta API
+	betaResp, err := clientBeta.GetAdministrativeUnit(ctx, beta.NewAdministrativeUnitID(id.AdministrativeUnitId), administrativeunitBeta.GetAdministrativeUnitOperationOptions{
+		Select: &[]string{"isMemberManagementRestricted", "membershipRule", "membershipRuleProcessingState", "membershipType"},
+	})
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving dynamic membership for %s", id)
+	}
+	if betaResp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving dynamic membership for %s", id)
+	}
+	tf.Set(d, "dynamic_membership", flattenAdministrativeUnitDynamicMembership(*betaResp.Model))
+	tf.Set(d, "is_member_management_restricted", betaResp.Model.IsMemberManagementRestricted.GetOrZero())
+
 	membersResp, err := memberClient.ListAdministrativeUnitMembers(ctx, *id, administrativeunitmember.DefaultListAdministrativeUnitMembersOperationOptions())
 	if err != nil {
 		return tf.ErrorDiagF(err, "Could not retrieve members for %s", id)
@@ -409,3 +479,38 @@ func administrativeUnitResourceDelete(ctx context.Context, d *pluginsdk.Resource
 
 	return nil
 }
+
+func expandAdministrativeUnitDynamicMembership(input []interface{}) beta.AdministrativeUnit {
+	if len(input) == 0 || input[0] == nil {
+		return beta.AdministrativeUnit{
+			MembershipRule: nullable.NoZero(""),
+			MembershipType: nullable.Value(administrativeUnitMembershipTypeAssigned),
+		}
+	}
+
+	in := input[0].(map[string]interface{})
+
+	processingState := administrativeUnitMembershipRuleProcessingStatePaused
+	if in["enabled"].(bool) {
+		processingState = administrativeUnitMembershipRuleProcessingStateOn
+	}
+
+	return beta.AdministrativeUnit{
+		MembershipRule:                nullable.Value(in["rule"].(string)),
+		MembershipRuleProcessingState: nullable.Value(processingState),
+		MembershipType:                nullable.Value(administrativeUnitMembershipTypeDynamic),
+	}
+}
+
+func flattenAdministrativeUnitDynamicMembership(administrativeUnit beta.AdministrativeUnit) []interface{} {
+	if !strings.EqualFold(administrativeUnit.MembershipType.GetOrZero(), administrativeUnitMembershipTypeDynamic) {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": administrativeUnit.MembershipRuleProcessingState.GetOrZero() != administrativeUnitMembershipRuleProcessingStatePaused,
+			"rule":    administrativeUnit.MembershipRule.GetOrZero(),
+		},
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/administrativeunitscopedrolemember"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
@@ -103,10 +104,27 @@ func administrativeUnitRoleMemberResourceCreate(ctx context.Context, d *pluginsd
 	if resp.Model == nil {
 		return tf.ErrorDiagF(errors.New("response was nil"), "Adding role member %q to administrative unit %q", memberId, administrativeUnitId)
 	}
+	if pointer.From(resp.Model.Id) == "" {
+		return tf.ErrorDiagF(errors.New("returned scoped role membership ID was nil"), "Adding role member %q to administrative unit %q", memberId, administrativeUnitId)
+	}
 
-	id := stable.NewDirectoryAdministrativeUnitIdScopedRoleMemberID(pointer.From(resp.Model.AdministrativeUnitId), pointer.From(resp.Model.Id))
+	id := stable.NewDirectoryAdministrativeUnitIdScopedRoleMemberID(administrativeUnitId, *resp.Model.Id)
 	d.SetId(id.ID())
 
+	// Wait for the scoped role membership to reflect
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetAdministrativeUnitScopedRoleMember(ctx, id, administrativeunitscopedrolemember.DefaultGetAdministrativeUnitScopedRoleMemberOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s", id)
+	}
+
 	return administrativeUnitRoleMemberResourceRead(ctx, d, meta)
 }
 
@@ -128,14 +146,20 @@ func administrativeUnitRoleMemberResourceRead(ctx context.Context, d *pluginsdk.
 		return tf.ErrorDiagF(err, "Retrieving role membership %q for administrative unit ID: %q", id.ScopedRoleMembershipId, id.AdministrativeUnitId)
 	}
 
-	if membership := resp.Model; membership != nil {
-		tf.Set(d, "administrative_unit_object_id", membership.AdministrativeUnitId)
-		tf.Set(d, "role_object_id", membership.RoleId)
+	membership := resp.Model
+	if membership == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving role membership %q for administrative unit ID: %q", id.ScopedRoleMembershipId, id.AdministrativeUnitId)
+	}
+
+	// The administrative unit ID is taken from the resource ID, since it is not always populated in the response
+	tf.Set(d, "administrative_unit_object_id", id.AdministrativeUnitId)
+	tf.Set(d, "role_object_id", pointer.From(membership.RoleId))
 
-		if membership.RoleMemberInfo != nil {
-			tf.Set(d, "member_object_id", membership.RoleMemberInfo.Identity().Id.GetOrZero())
-		}
+	memberId := ""
+	if membership.RoleMemberInfo != nil {
+		memberId = membership.RoleMemberInfo.Identity().Id.GetOrZero()
 	}
+	tf.Set(d, "member_object_id", memberId)
 
 	return nil
 }
@@ -148,9 +172,26 @@ func administrativeUnitRoleMemberResourceDelete(ctx context.Context, d *pluginsd
 		return tf.ErrorDiagPathF(err, "id", "Parsing Administrative Unit Role Member ID %q", d.Id())
 	}
 
-	if _, err = client.DeleteAdministrativeUnitScopedRoleMember(ctx, *id, administrativeunitscopedrolemember.DefaultDeleteAdministrativeUnitScopedRoleMemberOperationOptions()); err != nil {
+	if resp, err := client.DeleteAdministrativeUnitScopedRoleMember(ctx, *id, administrativeunitscopedrolemember.DefaultDeleteAdministrativeUnitScopedRoleMemberOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
 		return tf.ErrorDiagF(err, "Removing membership %q from administrative unit ID: %q", id.ScopedRoleMembershipId, id.AdministrativeUnitId)
 	}
 
+	// Wait for the scoped role membership to be deleted
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetAdministrativeUnitScopedRoleMember(ctx, *id, administrativeunitscopedrolemember.DefaultGetAdministrativeUnitScopedRoleMemberOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of %s", id)
+	}
+
 	return nil
 }
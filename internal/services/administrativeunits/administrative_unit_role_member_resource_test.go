@@ -30,6 +30,7 @@ func TestAccAdministrativeUnitRoleMember_user(t *testing.T) {
 			Config: r.oneUser(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("administrative_unit_object_id").IsUuid(),
 				check.That(data.ResourceName).Key("role_object_id").IsUuid(),
 				check.That(data.ResourceName).Key("member_object_id").IsUuid(),
 			),
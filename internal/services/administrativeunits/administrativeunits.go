@@ -6,12 +6,14 @@ package administrativeunits
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/administrativeunit"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directory/stable/administrativeunitmember"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 )
 
 func administrativeUnitFindByName(ctx context.Context, client *administrativeunit.AdministrativeUnitClient, displayName string) (*[]stable.AdministrativeUnit, error) {
@@ -59,3 +61,51 @@ func administrativeUnitGetMember(ctx context.Context, client *administrativeunit
 
 	return nil, nil
 }
+
+func administrativeUnitListMemberIds(ctx context.Context, client *administrativeunitmember.AdministrativeUnitMemberClient, id stable.DirectoryAdministrativeUnitId) (*[]string, error) {
+	resp, err := client.ListAdministrativeUnitMembers(ctx, id, administrativeunitmember.DefaultListAdministrativeUnitMembersOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	result := make([]string, 0)
+	for _, member := range pointer.From(resp.Model) {
+		if memberId := member.DirectoryObject().Id; memberId != nil {
+			result = append(result, *memberId)
+		}
+	}
+
+	return &result, nil
+}
+
+// administrativeUnitMembersChanges determines which members should be added to and removed from an administrative unit.
+// When exclusive is false, only members which were previously managed are removed, so that members added outside of
+// Terraform are retained.
+func administrativeUnitMembersChanges(existing, desired, managed []string, exclusive bool) (add []string, remove []string) {
+	add = tf.Difference(desired, existing)
+	remove = tf.Difference(administrativeUnitManagedMembers(existing, managed, exclusive), desired)
+	return
+}
+
+// administrativeUnitManagedMembers returns the existing members of an administrative unit which are managed by Terraform.
+// When exclusive is true all members are managed, otherwise only those which are already known are returned.
+func administrativeUnitManagedMembers(existing, known []string, exclusive bool) []string {
+	if exclusive {
+		return existing
+	}
+
+	result := make([]string, 0)
+	for _, member := range existing {
+		for _, k := range known {
+			if strings.EqualFold(member, k) {
+				result = append(result, member)
+				break
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package administrativeunits
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestAdministrativeUnitMembersChanges(t *testing.T) {
+	cases := []struct {
+		TestName       string
+		Existing       []string
+		Desired        []string
+		Managed        []string
+		Exclusive      bool
+		ExpectedAdd    []string
+		ExpectedRemove []string
+	}{
+		{
+			TestName:    "AddToEmpty",
+			Desired:     []string{"a", "b"},
+			ExpectedAdd: []string{"a", "b"},
+		},
+		{
+			TestName:       "NonExclusiveRetainsUnmanaged",
+			Existing:       []string{"a", "b", "external"},
+			Desired:        []string{"a", "c"},
+			Managed:        []string{"a", "b"},
+			ExpectedAdd:    []string{"c"},
+			ExpectedRemove: []string{"b"},
+		},
+		{
+			TestName:       "ExclusiveRemovesUnmanaged",
+			Existing:       []string{"a", "b", "external"},
+			Desired:        []string{"a", "c"},
+			Managed:        []string{"a", "b"},
+			Exclusive:      true,
+			ExpectedAdd:    []string{"c"},
+			ExpectedRemove: []string{"b", "external"},
+		},
+		{
+			TestName: "NonExclusiveIgnoresAlreadyRemoved",
+			Existing: []string{"a"},
+			Desired:  []string{"a"},
+			Managed:  []string{"a", "b"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			add, remove := administrativeUnitMembersChanges(tc.Existing, tc.Desired, tc.Managed, tc.Exclusive)
+			sort.Strings(add)
+			sort.Strings(remove)
+
+			if len(add) != len(tc.ExpectedAdd) || (len(add) > 0 && !reflect.DeepEqual(add, tc.ExpectedAdd)) {
+				t.Fatalf("expected members to add %v, got %v", tc.ExpectedAdd, add)
+			}
+			if len(remove) != len(tc.ExpectedRemove) || (len(remove) > 0 && !reflect.DeepEqual(remove, tc.ExpectedRemove)) {
+				t.Fatalf("expected members to remove %v, got %v", tc.ExpectedRemove, remove)
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azuread_administrative_unit":             administrativeUnitResource(),
 		"azuread_administrative_unit_member":      administrativeUnitMemberResource(),
+		"azuread_administrative_unit_members":     administrativeUnitMembersResource(),
 		"azuread_administrative_unit_role_member": administrativeUnitRoleMemberResource(),
 	}
 }
@@ -6,6 +6,7 @@ package applications
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
@@ -130,24 +132,12 @@ func (r ApplicationApiAccessResource) Create() sdk.ResourceFunc {
 
 			// Check for existing API
 			for _, api := range newApis {
-				if strings.EqualFold(*api.ResourceAppId, id.ApiClientId) {
+				if strings.EqualFold(pointer.From(api.ResourceAppId), id.ApiClientId) {
 					return metadata.ResourceRequiresImport(r.ResourceType(), id)
 				}
 			}
 
-			permissions := make([]stable.ResourceAccess, 0)
-			for _, roleId := range model.RoleIds {
-				permissions = append(permissions, stable.ResourceAccess{
-					Id:   pointer.To(roleId),
-					Type: nullable.Value(ResourceAccessTypeRole),
-				})
-			}
-			for _, scopeId := range model.ScopeIds {
-				permissions = append(permissions, stable.ResourceAccess{
-					Id:   pointer.To(scopeId),
-					Type: nullable.Value(ResourceAccessTypeScope),
-				})
-			}
+			permissions := applicationApiAccessPermissions(model.RoleIds, model.ScopeIds)
 
 			newApis = append(newApis, stable.RequiredResourceAccess{
 				ResourceAppId:  &model.ApiClientId,
@@ -164,6 +154,11 @@ func (r ApplicationApiAccessResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if err = applicationApiAccessWaitForPermissions(ctx, metadata, *id, permissions); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
 			return nil
 		},
 	}
@@ -205,7 +200,7 @@ func (r ApplicationApiAccessResource) Read() sdk.ResourceFunc {
 			// Identify the API
 			var api *stable.RequiredResourceAccess
 			for _, existingApi := range *app.RequiredResourceAccess {
-				if strings.EqualFold(*existingApi.ResourceAppId, id.ApiClientId) {
+				if strings.EqualFold(pointer.From(existingApi.ResourceAppId), id.ApiClientId) {
 					api = &existingApi
 					break
 				}
@@ -258,19 +253,7 @@ func (r ApplicationApiAccessResource) Update() sdk.ResourceFunc {
 			}
 
 			// Prepare a new API to replace the existing one
-			permissions := make([]stable.ResourceAccess, 0)
-			for _, roleId := range model.RoleIds {
-				permissions = append(permissions, stable.ResourceAccess{
-					Id:   pointer.To(roleId),
-					Type: nullable.Value(ResourceAccessTypeRole),
-				})
-			}
-			for _, scopeId := range model.ScopeIds {
-				permissions = append(permissions, stable.ResourceAccess{
-					Id:   pointer.To(scopeId),
-					Type: nullable.Value(ResourceAccessTypeScope),
-				})
-			}
+			permissions := applicationApiAccessPermissions(model.RoleIds, model.ScopeIds)
 			api := stable.RequiredResourceAccess{
 				ResourceAppId:  &model.ApiClientId,
 				ResourceAccess: &permissions,
@@ -295,7 +278,7 @@ func (r ApplicationApiAccessResource) Update() sdk.ResourceFunc {
 			newApis := make([]stable.RequiredResourceAccess, 0)
 			found := false
 			for _, existingApi := range *app.RequiredResourceAccess {
-				if strings.EqualFold(*existingApi.ResourceAppId, id.ApiClientId) {
+				if strings.EqualFold(pointer.From(existingApi.ResourceAppId), id.ApiClientId) {
 					newApis = append(newApis, api)
 					found = true
 				} else {
@@ -316,6 +299,10 @@ func (r ApplicationApiAccessResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
+			if err = applicationApiAccessWaitForPermissions(ctx, metadata, *id, permissions); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
 			return nil
 		},
 	}
@@ -356,7 +343,7 @@ func (r ApplicationApiAccessResource) Delete() sdk.ResourceFunc {
 			newApis := make([]stable.RequiredResourceAccess, 0)
 			found := false
 			for _, existingApi := range *app.RequiredResourceAccess {
-				if strings.EqualFold(*existingApi.ResourceAppId, id.ApiClientId) {
+				if strings.EqualFold(pointer.From(existingApi.ResourceAppId), id.ApiClientId) {
 					found = true
 				} else {
 					newApis = append(newApis, existingApi)
@@ -380,3 +367,60 @@ func (r ApplicationApiAccessResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func applicationApiAccessPermissions(roleIds, scopeIds []string) []stable.ResourceAccess {
+	permissions := make([]stable.ResourceAccess, 0)
+	for _, roleId := range roleIds {
+		permissions = append(permissions, stable.ResourceAccess{
+			Id:   pointer.To(roleId),
+			Type: nullable.Value(ResourceAccessTypeRole),
+		})
+	}
+	for _, scopeId := range scopeIds {
+		permissions = append(permissions, stable.ResourceAccess{
+			Id:   pointer.To(scopeId),
+			Type: nullable.Value(ResourceAccessTypeScope),
+		})
+	}
+	return permissions
+}
+
+// applicationApiAccessWaitForPermissions waits until the application reflects the expected permissions for the API,
+// so that a subsequent read does not observe a stale set of APIs whilst the change is replicated
+func applicationApiAccessWaitForPermissions(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ApiAccessId, expected []stable.ResourceAccess) error {
+	client := metadata.Client.Applications.ApplicationClient
+
+	expectedPermissions := make(map[string]bool)
+	for _, permission := range expected {
+		expectedPermissions[strings.ToLower(pointer.From(permission.Id)+"/"+permission.Type.GetOrZero())] = true
+	}
+
+	return consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetApplication(ctx, stable.NewApplicationID(id.ApplicationId), application.DefaultGetApplicationOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+
+		if resp.Model == nil || resp.Model.RequiredResourceAccess == nil {
+			return pointer.To(false), nil
+		}
+
+		for _, api := range *resp.Model.RequiredResourceAccess {
+			if !strings.EqualFold(pointer.From(api.ResourceAppId), id.ApiClientId) {
+				continue
+			}
+
+			actualPermissions := make(map[string]bool)
+			for _, permission := range pointer.From(api.ResourceAccess) {
+				actualPermissions[strings.ToLower(pointer.From(permission.Id)+"/"+permission.Type.GetOrZero())] = true
+			}
+
+			return pointer.To(reflect.DeepEqual(expectedPermissions, actualPermissions)), nil
+		}
+
+		return pointer.To(false), nil
+	})
+}
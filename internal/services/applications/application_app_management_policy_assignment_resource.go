@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/appmanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/policyassignments"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func applicationAppManagementPolicyAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: applicationAppManagementPolicyAssignmentResourceCreate,
+		ReadContext:   applicationAppManagementPolicyAssignmentResourceRead,
+		DeleteContext: applicationAppManagementPolicyAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateApplicationIdAppManagementPolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"application_id": {
+				Description:  "ID of the application for which to assign the policy",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidateApplicationID,
+			},
+
+			"app_management_policy_id": {
+				Description:  "ID of the app management policy to assign",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidatePolicyAppManagementPolicyID,
+			},
+		},
+	}
+}
+
+func applicationAppManagementPolicyAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.AppManagementPolicyClient
+
+	applicationId, err := stable.ParseApplicationID(d.Get("application_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "application_id", "Parsing `application_id`")
+	}
+
+	policyId, err := stable.ParsePolicyAppManagementPolicyID(d.Get("app_management_policy_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "app_management_policy_id", "Parsing `app_management_policy_id`")
+	}
+
+	id := stable.NewApplicationIdAppManagementPolicyID(applicationId.ApplicationId, policyId.AppManagementPolicyId)
+
+	tf.LockByName(applicationResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+	if diags := applicationAppManagementPolicyAssignment(client, id).Create(ctx, d, "azuread_application_app_management_policy_assignment", client.Client.BaseUri, func(ctx context.Context, ref stable.ReferenceCreate) error {
+		_, err := client.AddAppManagementPolicyRef(ctx, *applicationId, ref, appmanagementpolicy.DefaultAddAppManagementPolicyRefOperationOptions())
+		return err
+	}); diags.HasError() {
+		return diags
+	}
+
+	return applicationAppManagementPolicyAssignmentResourceRead(ctx, d, meta)
+}
+
+func applicationAppManagementPolicyAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.AppManagementPolicyClient
+
+	id, err := stable.ParseApplicationIdAppManagementPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing App Management Policy Assignment ID %q", d.Id())
+	}
+
+	policyId := stable.NewPolicyAppManagementPolicyID(id.AppManagementPolicyId)
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	exists, err := applicationAppManagementPolicyAssignment(client, *id).Exists(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "listing App Management Policy Assignments for %s", applicationId)
+	}
+	if exists == nil || !*exists {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "application_id", applicationId.ID())
+	tf.Set(d, "app_management_policy_id", policyId.ID())
+
+	return nil
+}
+
+func applicationAppManagementPolicyAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.AppManagementPolicyClient
+
+	id, err := stable.ParseApplicationIdAppManagementPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing App Management Policy Assignment ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+	return applicationAppManagementPolicyAssignment(client, *id).Delete(ctx, func(ctx context.Context) (*http.Response, error) {
+		resp, err := client.RemoveAppManagementPolicyRef(ctx, *id, appmanagementpolicy.DefaultRemoveAppManagementPolicyRefOperationOptions())
+		return resp.HttpResponse, err
+	})
+}
+
+// applicationAppManagementPolicyAssignment describes the assignment of an app management policy to an application
+func applicationAppManagementPolicyAssignment(client *appmanagementpolicy.AppManagementPolicyClient, id stable.ApplicationIdAppManagementPolicyId) policyassignments.Assignment[stable.AppManagementPolicy] {
+	return policyassignments.Assignment[stable.AppManagementPolicy]{
+		ID:       &id,
+		PolicyId: id.AppManagementPolicyId,
+		List: func(ctx context.Context) (*http.Response, *[]stable.AppManagementPolicy, error) {
+			resp, err := client.ListAppManagementPolicies(ctx, stable.NewApplicationID(id.ApplicationId), appmanagementpolicy.DefaultListAppManagementPoliciesOperationOptions())
+			return resp.HttpResponse, resp.Model, err
+		},
+	}
+}
@@ -298,8 +298,10 @@ func (r ApplicationAppRoleResource) Update() sdk.ResourceFunc {
 				AppRoles: &newRoles,
 			}
 
-			// Patch the application with the new set of roles
-			if _, err = client.UpdateApplication(ctx, applicationId, properties, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+			// Patch the application with the new set of roles, retrying whilst the disabled role is replicated
+			if _, err = client.UpdateApplication(ctx, applicationId, properties, application.UpdateApplicationOperationOptions{
+				RetryFunc: applicationUpdateRetryFunc(),
+			}); err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
@@ -363,8 +365,10 @@ func (r ApplicationAppRoleResource) Delete() sdk.ResourceFunc {
 				AppRoles: &newRoles,
 			}
 
-			// Patch the application with the new set of roles
-			if _, err = client.UpdateApplication(ctx, applicationId, properties, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+			// Patch the application with the new set of roles, retrying whilst the disabled role is replicated
+			if _, err = client.UpdateApplication(ctx, applicationId, properties, application.UpdateApplicationOperationOptions{
+				RetryFunc: applicationUpdateRetryFunc(),
+			}); err != nil {
 				return fmt.Errorf("deleting %s: %+v", id, err)
 			}
 
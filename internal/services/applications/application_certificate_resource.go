@@ -6,6 +6,7 @@ package applications
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -26,8 +27,11 @@ func applicationCertificateResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		CreateContext: applicationCertificateResourceCreate,
 		ReadContext:   applicationCertificateResourceRead,
+		UpdateContext: applicationCertificateResourceUpdate,
 		DeleteContext: applicationCertificateResourceDelete,
 
+		CustomizeDiff: credentials.ValidityCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -35,10 +39,10 @@ func applicationCertificateResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.CertificateID(id)
 			return err
-		}),
+		}, applicationCertificateResourceImport),
 
 		Schema: map[string]*pluginsdk.Schema{
 			"application_id": {
@@ -100,6 +104,23 @@ func applicationCertificateResource() *pluginsdk.Resource {
 				Deprecated:    "The `end_date_relative` property is deprecated and will be removed in a future version of the AzureAD provider. Please instead use the Terraform `timeadd()` function to calculate a value for the `end_date` property.",
 			},
 
+			"maximum_validity": {
+				Description:  "The maximum permitted validity period of the certificate, for example `17520h` (2 years). An error is raised when planning if the validity period resolved from the start and end dates exceeds this duration",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsPositiveDuration,
+			},
+
+			"rotate_when_changed": {
+				Description: "Arbitrary map of values that, when changed, will trigger rotation of the certificate",
+				Type:        pluginsdk.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"type": {
 				Description: "The type of key/certificate",
 				Type:        pluginsdk.TypeString,
@@ -173,7 +194,11 @@ func applicationCertificateResourceCreate(ctx context.Context, d *pluginsdk.Reso
 		Id:             &id.ObjectId,
 		KeyCredentials: &newCredentials,
 	}
-	if _, err = client.UpdateApplication(ctx, *applicationId, properties, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+	if resp, err := client.UpdateApplication(ctx, *applicationId, properties, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+		if credentials.IsPolicyViolation(resp.OData) {
+			attr := credentials.EndDateAttr(d)
+			return tf.ErrorDiagPathF(err, attr, "The certificate for %s was rejected by an app management policy. Check that the validity period determined by `%s` is permitted by the policies applying to the application", applicationId, attr)
+		}
 		return tf.ErrorDiagF(err, "Adding certificate for %s", applicationId)
 	}
 
@@ -215,7 +240,7 @@ func applicationCertificateResourceCreate(ctx context.Context, d *pluginsdk.Reso
 
 	d.SetId(id.String())
 
-	return applicationCertificateResourceRead(ctx, d, meta)
+	return append(credentials.KeyCredentialValidityWarnings(*credential, time.Now()), applicationCertificateResourceRead(ctx, d, meta)...)
 }
 
 func applicationCertificateResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
@@ -254,6 +279,12 @@ func applicationCertificateResourceRead(ctx context.Context, d *pluginsdk.Resour
 	return nil
 }
 
+// applicationCertificateResourceUpdate only handles changes to `maximum_validity`, which is not sent to the API, since
+// all other properties force a new resource
+func applicationCertificateResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	return applicationCertificateResourceRead(ctx, d, meta)
+}
+
 func applicationCertificateResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Applications.ApplicationClient
 
@@ -317,3 +348,36 @@ func applicationCertificateResourceDelete(ctx context.Context, d *pluginsdk.Reso
 
 	return nil
 }
+
+// applicationCertificateResourceImport resolves an import ID specifying a certificate thumbprint to the ID of the matching
+// certificate credential
+func applicationCertificateResourceImport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).Applications.ApplicationClient
+
+	id, err := parse.CertificateID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if id.Thumbprint == "" {
+		return []*pluginsdk.ResourceData{d}, nil
+	}
+
+	applicationId := stable.NewApplicationID(id.ObjectId)
+
+	resp, err := client.GetApplication(ctx, applicationId, application.DefaultGetApplicationOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", applicationId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", applicationId)
+	}
+
+	keyId, err := credentials.GetVerifyKeyCredentialIdFromThumbprint(resp.Model.KeyCredentials, id.Thumbprint)
+	if err != nil {
+		return nil, fmt.Errorf("resolving certificate for %s: %+v", applicationId, err)
+	}
+
+	d.SetId(parse.NewCredentialID(id.ObjectId, "certificate", keyId).String())
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
@@ -6,6 +6,7 @@ package applications_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,10 +14,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/applications/parse"
 )
 
@@ -155,6 +158,31 @@ func TestAccApplicationCertificate_relativeEndDate(t *testing.T) {
 	})
 }
 
+func TestAccApplicationCertificate_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ApplicationCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotation(data, endDate, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "rotate_when_changed", "value"),
+		{
+			Config: r.rotation(data, endDate, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "rotate_when_changed", "value"),
+	})
+}
+
 func TestAccApplicationCertificate_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
 	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
@@ -172,6 +200,28 @@ func TestAccApplicationCertificate_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccApplicationCertificate_importByThumbprint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ApplicationCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			ResourceName:            data.ResourceName,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"encoding", "end_date_relative", "value"},
+			ImportStateIdFunc:       r.thumbprintImportId(data),
+		},
+	})
+}
+
 func (ApplicationCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Applications.ApplicationClient
 
@@ -206,6 +256,23 @@ func (ApplicationCertificateResource) Exists(ctx context.Context, clients *clien
 	return pointer.To(false), nil
 }
 
+func (ApplicationCertificateResource) thumbprintImportId(data acceptance.TestData) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", data.ResourceName)
+		}
+
+		thumbprint, err := credentials.GetTokenSigningCertificateThumbprint([]byte(applicationCertificatePem))
+		if err != nil {
+			return "", err
+		}
+
+		objectId := strings.Split(rs.Primary.ID, "/")[0]
+		return fmt.Sprintf("%s/certificate/%s%s", objectId, credentials.CertificateThumbprintPrefix, thumbprint), nil
+	}
+}
+
 func (ApplicationCertificateResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -294,6 +361,29 @@ EOT
 `, r.template(data), applicationCertificatePem)
 }
 
+func (r ApplicationCertificateResource) rotation(data acceptance.TestData, endDate, rotation string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application_certificate" "test" {
+  application_id = azuread_application.test.id
+  type           = "AsymmetricX509Cert"
+  end_date       = "%[2]s"
+  value          = <<EOT
+%[3]s
+EOT
+
+  rotate_when_changed = {
+    rotation = "%[4]s"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, r.template(data), endDate, applicationCertificatePem, rotation)
+}
+
 func (r ApplicationCertificateResource) requiresImport(data acceptance.TestData, endDate string) string {
 	return fmt.Sprintf(`
 %[1]s
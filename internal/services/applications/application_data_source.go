@@ -152,6 +152,30 @@ func applicationDataSource() *pluginsdk.Resource {
 							},
 						},
 
+						"pre_authorized_applications": {
+							Description: "List of client applications which are pre-authorized to access this application's delegated permissions",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"authorized_client_id": {
+										Description: "The client ID of the pre-authorized application",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"permission_ids": {
+										Description: "The IDs of the permission scopes required by the pre-authorized application",
+										Type:        pluginsdk.TypeList,
+										Computed:    true,
+										Elem: &pluginsdk.Schema{
+											Type: pluginsdk.TypeString,
+										},
+									},
+								},
+							},
+						},
+
 						"requested_access_token_version": {
 							Description: "Specifies the access token version expected by this resource",
 							Type:        pluginsdk.TypeInt,
@@ -300,6 +324,24 @@ func applicationDataSource() *pluginsdk.Resource {
 				Computed:    true,
 			},
 
+			"password_credential_count": {
+				Description: "The number of password credentials (client secrets) for the application",
+				Type:        pluginsdk.TypeInt,
+				Computed:    true,
+			},
+
+			"key_credential_count": {
+				Description: "The number of key credentials (certificates) for the application",
+				Type:        pluginsdk.TypeInt,
+				Computed:    true,
+			},
+
+			"earliest_credential_end_date": {
+				Description: "The earliest end date of all password and key credentials for the application, formatted as an RFC3339 date string",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
 			"marketing_url": {
 				Description: "URL of the application's marketing page",
 				Type:        pluginsdk.TypeString,
@@ -592,13 +634,16 @@ func applicationDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, m
 	tf.Set(d, "device_only_auth_enabled", app.IsDeviceOnlyAuthSupported.GetOrZero())
 	tf.Set(d, "disabled_by_microsoft", app.DisabledByMicrosoftStatus.GetOrZero())
 	tf.Set(d, "display_name", app.DisplayName.GetOrZero())
+	tf.Set(d, "earliest_credential_end_date", applicationEarliestCredentialEndDate(*app))
 	tf.Set(d, "fallback_public_client_enabled", app.IsFallbackPublicClient.GetOrZero())
 	tf.Set(d, "feature_tags", applications.FlattenFeatures(app.Tags, false))
 	tf.Set(d, "group_membership_claims", flattenApplicationGroupMembershipClaims(app.GroupMembershipClaims))
 	tf.Set(d, "identifier_uris", tf.FlattenStringSlicePtr(app.IdentifierUris))
+	tf.Set(d, "key_credential_count", len(pointer.From(app.KeyCredentials)))
 	tf.Set(d, "notes", app.Notes.GetOrZero())
 	tf.Set(d, "object_id", pointer.From(app.Id))
 	tf.Set(d, "optional_claims", flattenApplicationOptionalClaims(app.OptionalClaims))
+	tf.Set(d, "password_credential_count", len(pointer.From(app.PasswordCredentials)))
 	tf.Set(d, "public_client", flattenApplicationPublicClient(app.PublicClient))
 	tf.Set(d, "publisher_domain", app.PublisherDomain.GetOrZero())
 	tf.Set(d, "required_resource_access", flattenApplicationRequiredResourceAccess(app.RequiredResourceAccess))
@@ -608,9 +653,11 @@ func applicationDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, m
 	tf.Set(d, "tags", tf.FlattenStringSlicePtr(app.Tags))
 	tf.Set(d, "web", flattenApplicationWeb(app.Web))
 
+	oauth2PermissionScopeIds := applications.FlattenOAuth2PermissionScopeIDs(nil)
 	if app.Api != nil {
-		tf.Set(d, "oauth2_permission_scope_ids", applications.FlattenOAuth2PermissionScopeIDs(app.Api.OAuth2PermissionScopes))
+		oauth2PermissionScopeIds = applications.FlattenOAuth2PermissionScopeIDs(app.Api.OAuth2PermissionScopes)
 	}
+	tf.Set(d, "oauth2_permission_scope_ids", oauth2PermissionScopeIds)
 
 	if app.Info != nil {
 		tf.Set(d, "logo_url", app.Info.LogoUrl.GetOrZero())
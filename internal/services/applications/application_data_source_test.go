@@ -77,6 +77,8 @@ func (ApplicationDataSource) testCheck(data acceptance.TestData) acceptance.Test
 		check.That(data.ResourceName).Key("group_membership_claims.#").HasValue("1"),
 		check.That(data.ResourceName).Key("group_membership_claims.0").HasValue("All"),
 		check.That(data.ResourceName).Key("identifier_uris.#").HasValue("2"),
+		check.That(data.ResourceName).Key("key_credential_count").HasValue("0"),
+		check.That(data.ResourceName).Key("password_credential_count").HasValue("0"),
 		check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
 		check.That(data.ResourceName).Key("optional_claims.#").HasValue("1"),
 		check.That(data.ResourceName).Key("optional_claims.0.access_token.#").HasValue("2"),
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/federatedidentitycredential"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func applicationFederatedIdentityCredentialDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: applicationFederatedIdentityCredentialDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"application_id": {
+				Description:  "The resource ID of the application for which to retrieve federated identity credentials",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: stable.ValidateApplicationID,
+			},
+
+			"credential_id": {
+				Description:  "The ID of a specific federated identity credential to retrieve. When omitted, all federated identity credentials for the application are returned",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"federated_identity_credentials": {
+				Description: "A list of federated identity credentials for the application",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"audiences": {
+							Description: "List of audiences that can appear in the external token",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"credential_id": {
+							Description: "A UUID used to uniquely identify this federated identity credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"description": {
+							Description: "A description for the federated identity credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description: "The unique display name of the federated identity credential",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"issuer": {
+							Description: "The URL of the external identity provider",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"subject": {
+							Description: "The identifier of the external software workload within the external identity provider",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func applicationFederatedIdentityCredentialDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.ApplicationFederatedIdentityCredential
+
+	applicationId, err := stable.ParseApplicationID(d.Get("application_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "application_id", "Parsing `application_id`")
+	}
+
+	resp, err := client.ListFederatedIdentityCredentialsComplete(ctx, *applicationId, federatedidentitycredential.DefaultListFederatedIdentityCredentialsOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			return tf.ErrorDiagPathF(nil, "application_id", "%s was not found", applicationId)
+		}
+		return tf.ErrorDiagF(err, "Listing federated identity credentials for %s", applicationId)
+	}
+
+	credentialId := d.Get("credential_id").(string)
+
+	credentialIds := make([]string, 0)
+	credentials := make([]map[string]interface{}, 0)
+	for _, credential := range resp.Items {
+		id := pointer.From(credential.Id)
+		if credentialId != "" && !strings.EqualFold(id, credentialId) {
+			continue
+		}
+
+		credentialIds = append(credentialIds, id)
+		credentials = append(credentials, map[string]interface{}{
+			"audiences":     credential.Audiences,
+			"credential_id": id,
+			"description":   credential.Description.GetOrZero(),
+			"display_name":  credential.Name,
+			"issuer":        credential.Issuer,
+			"subject":       credential.Subject,
+		})
+	}
+
+	if credentialId != "" && len(credentials) == 0 {
+		return tf.ErrorDiagPathF(nil, "credential_id", "Federated identity credential with ID %q was not found for %s", credentialId, applicationId)
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(applicationId.ApplicationId + "/" + strings.Join(credentialIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for federated identity credential IDs")
+	}
+
+	d.SetId("federatedIdentityCredentials#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "federated_identity_credentials", credentials)
+
+	return nil
+}
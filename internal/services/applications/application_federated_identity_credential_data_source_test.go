@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ApplicationFederatedIdentityCredentialDataSource struct{}
+
+func TestAccApplicationFederatedIdentityCredentialDataSource_all(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.all(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("federated_identity_credentials.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccApplicationFederatedIdentityCredentialDataSource_byCredentialId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_application_federated_identity_credential", "test")
+	r := ApplicationFederatedIdentityCredentialDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.byCredentialId(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("federated_identity_credentials.#").HasValue("1"),
+				check.That(data.ResourceName).Key("federated_identity_credentials.0.credential_id").Exists(),
+				check.That(data.ResourceName).Key("federated_identity_credentials.0.audiences.#").HasValue("1"),
+				check.That(data.ResourceName).Key("federated_identity_credentials.0.audiences.0").HasValue("api://HashiTownLikesAzureAD"),
+				check.That(data.ResourceName).Key("federated_identity_credentials.0.description").HasValue("Funtime tokens for HashiTown"),
+				check.That(data.ResourceName).Key("federated_identity_credentials.0.issuer").HasValue("https://tokens.hashitown.net"),
+				check.That(data.ResourceName).Key("federated_identity_credentials.0.subject").HasValue(fmt.Sprintf("subject-one-%s", data.RandomString)),
+			),
+		},
+	})
+}
+
+func (ApplicationFederatedIdentityCredentialDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestFederatedIdentityCredential-%[1]d"
+}
+
+resource "azuread_application_federated_identity_credential" "one" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown-one-%[2]s"
+  description    = "Funtime tokens for HashiTown"
+  audiences      = ["api://HashiTownLikesAzureAD"]
+  issuer         = "https://tokens.hashitown.net"
+  subject        = "subject-one-%[2]s"
+}
+
+resource "azuread_application_federated_identity_credential" "two" {
+  application_id = azuread_application.test.id
+  display_name   = "hashitown-two-%[2]s"
+  audiences      = ["api://HashiTownLikesAzureAD"]
+  issuer         = "https://tokens.hashitown.net"
+  subject        = "subject-two-%[2]s"
+}
+`, data.RandomInteger, data.RandomString)
+}
+
+func (r ApplicationFederatedIdentityCredentialDataSource) all(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+
+  depends_on = [
+    azuread_application_federated_identity_credential.one,
+    azuread_application_federated_identity_credential.two,
+  ]
+}
+`, r.template(data))
+}
+
+func (r ApplicationFederatedIdentityCredentialDataSource) byCredentialId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_application_federated_identity_credential" "test" {
+  application_id = azuread_application.test.id
+  credential_id  = azuread_application_federated_identity_credential.one.credential_id
+}
+`, r.template(data))
+}
@@ -111,6 +111,10 @@ func (r ApplicationIdentifierUriResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if err = applicationCheckIdentifierUrisAvailable(ctx, client, []string{model.IdentifierUri}, applicationId.ApplicationId); err != nil {
+				return fmt.Errorf("checking `identifier_uri`: %v", err)
+			}
+
 			newIdentifierUris = append(newIdentifierUris, model.IdentifierUri)
 
 			properties := stable.Application{
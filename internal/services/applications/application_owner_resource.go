@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/applications"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
@@ -113,6 +114,18 @@ func (r ApplicationOwnerResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			// Wait for the owner to be listed for the application
+			if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+				o, err := applications.GetOwner(ctx, client, id)
+				if err != nil {
+					return nil, err
+				}
+				return pointer.To(o != nil), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for %s: %+v", id, err)
+			}
+
 			return nil
 		},
 	}
@@ -169,10 +182,25 @@ func (r ApplicationOwnerResource) Delete() sdk.ResourceFunc {
 			tf.LockByName(applicationResourceName, id.ApplicationId)
 			defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
 
-			if _, err = client.RemoveOwnerRef(ctx, ownerId, owner.DefaultRemoveOwnerRefOperationOptions()); err != nil {
+			if resp, err := client.RemoveOwnerRef(ctx, ownerId, owner.DefaultRemoveOwnerRefOperationOptions()); err != nil {
+				// A 404 indicates the owner was already removed, or the application no longer exists
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
 				return fmt.Errorf("removing %s: %+v", id, err)
 			}
 
+			// Wait for the owner to no longer be listed for the application
+			if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+				o, err := applications.GetOwner(ctx, client, ownerId)
+				if err != nil {
+					return nil, err
+				}
+				return pointer.To(o != nil), nil
+			}); err != nil {
+				return fmt.Errorf("waiting for removal of %s: %+v", id, err)
+			}
+
 			return nil
 		},
 	}
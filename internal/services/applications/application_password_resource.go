@@ -103,6 +103,12 @@ func applicationPasswordResource() *pluginsdk.Resource {
 				},
 			},
 
+			"hint": {
+				Description: "The first few characters of the password, which can be used to identify the password in the Azure Portal",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
 			"key_id": {
 				Description: "A UUID used to uniquely identify this password credential",
 				Type:        pluginsdk.TypeString,
@@ -260,6 +266,7 @@ func applicationPasswordResourceRead(ctx context.Context, d *pluginsdk.ResourceD
 		tf.Set(d, "display_name", string(displayName))
 	}
 
+	tf.Set(d, "hint", credential.Hint.GetOrZero())
 	tf.Set(d, "key_id", id.KeyId)
 	tf.Set(d, "start_date", credential.StartDateTime.GetOrZero())
 	tf.Set(d, "end_date", credential.EndDateTime.GetOrZero())
@@ -32,6 +32,7 @@ func TestAccApplicationPassword_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("end_date").Exists(),
+				check.That(data.ResourceName).Key("hint").Exists(),
 				check.That(data.ResourceName).Key("key_id").Exists(),
 				check.That(data.ResourceName).Key("start_date").Exists(),
 				check.That(data.ResourceName).Key("value").Exists(),
@@ -52,6 +53,7 @@ func TestAccApplicationPassword_complete(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("end_date").Exists(),
+				check.That(data.ResourceName).Key("hint").Exists(),
 				check.That(data.ResourceName).Key("key_id").Exists(),
 				check.That(data.ResourceName).Key("start_date").Exists(),
 				check.That(data.ResourceName).Key("value").Exists(),
@@ -317,8 +317,10 @@ func (r ApplicationPermissionScopeResource) Update() sdk.ResourceFunc {
 				},
 			}
 
-			// Patch the application with the new set of scopes
-			if _, err = client.UpdateApplication(ctx, applicationId, properties, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+			// Patch the application with the new set of scopes, retrying whilst the disabled scope is replicated
+			if _, err = client.UpdateApplication(ctx, applicationId, properties, application.UpdateApplicationOperationOptions{
+				RetryFunc: applicationUpdateRetryFunc(),
+			}); err != nil {
 				return fmt.Errorf("updating %s: %+v", id, err)
 			}
 
@@ -382,8 +384,10 @@ func (r ApplicationPermissionScopeResource) Delete() sdk.ResourceFunc {
 				},
 			}
 
-			// Patch the application with the new set of scopes
-			if _, err = client.UpdateApplication(ctx, applicationId, properties, application.DefaultUpdateApplicationOperationOptions()); err != nil {
+			// Patch the application with the new set of scopes, retrying whilst the disabled scope is replicated
+			if _, err = client.UpdateApplication(ctx, applicationId, properties, application.UpdateApplicationOperationOptions{
+				RetryFunc: applicationUpdateRetryFunc(),
+			}); err != nil {
 				return fmt.Errorf("deleting %s: %+v", id, err)
 			}
 
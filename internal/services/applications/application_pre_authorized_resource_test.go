@@ -141,6 +141,12 @@ resource "azuread_application" "authorizer" {
       value                      = "user_impersonation"
     }
   }
+
+  lifecycle {
+    ignore_changes = [
+      api[0].pre_authorized_application,
+    ]
+  }
 }
 
 resource "azuread_application_pre_authorized" "test" {
@@ -190,6 +196,12 @@ resource "azuread_application" "authorizer" {
       value                      = "administer"
     }
   }
+
+  lifecycle {
+    ignore_changes = [
+      api[0].pre_authorized_application,
+    ]
+  }
 }
 
 resource "azuread_application_pre_authorized" "authorize_1" {
@@ -239,6 +251,12 @@ resource "azuread_application" "authorizer" {
       value                      = "administer"
     }
   }
+
+  lifecycle {
+    ignore_changes = [
+      api[0].pre_authorized_application,
+    ]
+  }
 }
 `, data.RandomInteger)
 }
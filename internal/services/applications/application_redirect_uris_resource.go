@@ -25,7 +25,10 @@ type ApplicationRedirectUrisModel struct {
 	RedirectUris  []string `tfschema:"redirect_uris"`
 }
 
-var _ sdk.ResourceWithUpdate = ApplicationRedirectUrisResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ApplicationRedirectUrisResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApplicationRedirectUrisResource{}
+)
 
 type ApplicationRedirectUrisResource struct{}
 
@@ -75,6 +78,31 @@ func (r ApplicationRedirectUrisResource) Attributes() map[string]*pluginsdk.Sche
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r ApplicationRedirectUrisResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if metadata.ResourceDiff.Get("type").(string) != RedirectUriTypeSPA {
+				return nil
+			}
+
+			// Single-page applications use the authorization code flow with PKCE, for which the redirect URIs must
+			// be secured with https, with the exception of local loopback addresses used during development
+			for _, v := range metadata.ResourceDiff.Get("redirect_uris").(*pluginsdk.Set).List() {
+				uri, ok := v.(string)
+				if !ok || uri == "" {
+					continue
+				}
+				if _, errs := validation.IsSpaRedirectUri(uri, "redirect_uris"); len(errs) > 0 {
+					return errs[0]
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r ApplicationRedirectUrisResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 10 * time.Minute,
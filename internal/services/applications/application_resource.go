@@ -175,6 +175,32 @@ func applicationResource() *pluginsdk.Resource {
 							},
 						},
 
+						"pre_authorized_application": {
+							Description: "One or more `pre_authorized_application` blocks to describe client applications which are pre-authorized to access this application's delegated permissions without requiring user consent",
+							Type:        pluginsdk.TypeSet,
+							Optional:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"authorized_client_id": {
+										Description:  "The client ID of the application being authorized",
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+
+									"permission_ids": {
+										Description: "The IDs of the permission scopes required by the pre-authorized application",
+										Type:        pluginsdk.TypeSet,
+										Required:    true,
+										Elem: &pluginsdk.Schema{
+											Type:         pluginsdk.TypeString,
+											ValidateFunc: validation.IsUUID,
+										},
+									},
+								},
+							},
+						},
+
 						"requested_access_token_version": {
 							Description: "The access token version expected by this resource",
 							Type:        pluginsdk.TypeInt,
@@ -344,10 +370,10 @@ func applicationResource() *pluginsdk.Resource {
 			},
 
 			"logo_image": {
-				Description:  "Base64 encoded logo image in gif, png or jpeg format",
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringIsBase64,
+				Description:      "Base64 encoded logo image in gif, png or jpeg format",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: applicationsValidate.LogoImage,
 			},
 
 			"marketing_url": {
@@ -544,7 +570,7 @@ func applicationResource() *pluginsdk.Resource {
 							MaxItems:    256,
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.IsRedirectUriFunc(false, false),
+								ValidateFunc: validation.IsSpaRedirectUri,
 							},
 						},
 					},
@@ -707,6 +733,25 @@ func applicationResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.Resou
 		}
 	}
 
+	// Check that any newly added identifier URIs are not already in use by another application
+	if diff.NewValueKnown("identifier_uris") && diff.HasChange("identifier_uris") {
+		oldIdentifierUris, newIdentifierUris := diff.GetChange("identifier_uris")
+		addedIdentifierUris := tf.ExpandStringSlice(newIdentifierUris.(*pluginsdk.Set).Difference(oldIdentifierUris.(*pluginsdk.Set)).List())
+
+		objectId := ""
+		if diff.Id() != "" {
+			id, err := stable.ParseApplicationID(diff.Id())
+			if err != nil {
+				return fmt.Errorf("parsing application ID: %v", err)
+			}
+			objectId = id.ApplicationId
+		}
+
+		if err := applicationCheckIdentifierUrisAvailable(ctx, client, addedIdentifierUris, objectId); err != nil {
+			return fmt.Errorf("checking `identifier_uris`: %v", err)
+		}
+	}
+
 	// Validate roles and scopes to check for duplicate IDs or values
 	if err := applicationValidateRolesScopes(diff.Get("app_role").(*pluginsdk.Set).List(), diff.Get("api.0.oauth2_permission_scope").(*pluginsdk.Set).List()); err != nil {
 		return fmt.Errorf("checking for duplicate app roles / OAuth2.0 permission scopes: %v", err)
@@ -860,6 +905,9 @@ func applicationDiffSuppress(k, old, new string, d *pluginsdk.ResourceData) bool
 			if v, ok := api["oauth2_permission_scope"]; ok && len(v.(*pluginsdk.Set).List()) > 0 {
 				suppress = false
 			}
+			if v, ok := api["pre_authorized_application"]; ok && len(v.(*pluginsdk.Set).List()) > 0 {
+				suppress = false
+			}
 			if v, ok := api["requested_access_token_version"]; ok && v.(int) > 1 {
 				suppress = false
 			}
@@ -1284,6 +1332,16 @@ func applicationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, m
 		}
 	}
 
+	// Pre-authorized applications reference permission scopes, so they are set once the application has been created
+	if v := d.Get("api.0.pre_authorized_application").(*pluginsdk.Set).List(); len(v) > 0 {
+		api.PreAuthorizedApplications = expandApplicationPreAuthorizedApplications(v)
+		if _, err = client.UpdateApplication(ctx, id, stable.Application{Api: api}, application.UpdateApplicationOperationOptions{
+			RetryFunc: applicationUpdateRetryFunc(),
+		}); err != nil {
+			return tf.ErrorDiagPathF(err, "api.0.pre_authorized_application", "Failed to patch application after creating to set `api.0.pre_authorized_application` property")
+		}
+	}
+
 	// Add any remaining owners after the application is created
 	for _, ref := range ownersExtra {
 		if _, err = ownerClient.AddOwnerRef(ctx, id, ref, owner.DefaultAddOwnerRefOperationOptions()); err != nil {
@@ -1525,6 +1583,12 @@ func applicationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, m
 		api.OAuth2PermissionScopes = nil
 	}
 
+	// Pre-authorized applications are only sent when changed, so that any managed with the
+	// `azuread_application_pre_authorized` resource are retained when `ignore_changes` is used for this block
+	if d.HasChange("api.0.pre_authorized_application") {
+		api.PreAuthorizedApplications = expandApplicationPreAuthorizedApplications(d.Get("api.0.pre_authorized_application").(*pluginsdk.Set).List())
+	}
+
 	if d.HasChange("identifier_uris") {
 		properties.IdentifierUris = tf.ExpandStringSlicePtr(d.Get("identifier_uris").(*pluginsdk.Set).List())
 	}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -71,6 +72,8 @@ func TestAccApplication_complete(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("client_id").Exists(),
 				check.That(data.ResourceName).Key("object_id").Exists(),
+				check.That(data.ResourceName).Key("optional_claims.0.saml2_token.#").HasValue("1"),
+				check.That(data.ResourceName).Key("optional_claims.0.saml2_token.0.name").HasValue("samlexample"),
 			),
 		},
 		data.ImportStep(),
@@ -331,6 +334,44 @@ func TestAccApplication_oauth2PermissionScopes(t *testing.T) {
 	})
 }
 
+func TestAccApplication_preAuthorizedApplications(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+	scopeIDs := []string{
+		data.UUID(),
+		data.UUID(),
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.preAuthorizedApplications(data, scopeIDs, scopeIDs[:1]),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.pre_authorized_application.#").HasValue("1"),
+				check.That(data.ResourceName).Key("api.0.pre_authorized_application.0.permission_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.preAuthorizedApplications(data, scopeIDs, scopeIDs),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.pre_authorized_application.#").HasValue("1"),
+				check.That(data.ResourceName).Key("api.0.pre_authorized_application.0.permission_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.preAuthorizedApplications(data, scopeIDs, nil),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api.0.pre_authorized_application.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccApplication_owners(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -443,6 +484,24 @@ func TestAccApplication_preventDuplicateNamesFail(t *testing.T) {
 	})
 }
 
+func TestAccApplication_duplicateIdentifierUri(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application", "test")
+	r := ApplicationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.identifierUri(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.duplicateIdentifierUri(data),
+			ExpectError: regexp.MustCompile("is already in use by the application"),
+		},
+	})
+}
+
 func TestAccApplication_related(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_application", "test")
 	r := ApplicationResource{}
@@ -1187,6 +1246,55 @@ resource "azuread_application" "test" {
 `, data.RandomInteger, scopeIDs[0], scopeIDs[1])
 }
 
+func (ApplicationResource) preAuthorizedApplications(data acceptance.TestData, scopeIDs []string, preAuthorizedScopeIDs []string) string {
+	preAuthorizedApplication := ""
+	if len(preAuthorizedScopeIDs) > 0 {
+		preAuthorizedApplication = fmt.Sprintf(`
+    pre_authorized_application {
+      authorized_client_id = azuread_application_registration.authorized.client_id
+      permission_ids       = ["%s"]
+    }
+`, strings.Join(preAuthorizedScopeIDs, `", "`))
+	}
+
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application_registration" "authorized" {
+  display_name = "acctest-APP-authorized-%[1]d"
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-APP-%[1]d"
+
+  api {
+    known_client_applications = [azuread_application_registration.authorized.client_id]
+
+    oauth2_permission_scope {
+      admin_consent_description  = "Allow the application to access acctest-APP-%[1]d on behalf of the signed-in user."
+      admin_consent_display_name = "Access acctest-APP-%[1]d"
+      enabled                    = true
+      id                         = "%[2]s"
+      type                       = "User"
+      user_consent_description   = "Allow the application to access acctest-APP-%[1]d on your behalf."
+      user_consent_display_name  = "Access acctest-APP-%[1]d"
+      value                      = "user_impersonation"
+    }
+
+    oauth2_permission_scope {
+      admin_consent_description  = "Administer the application"
+      admin_consent_display_name = "Administer"
+      enabled                    = true
+      id                         = "%[3]s"
+      type                       = "Admin"
+      value                      = "administer"
+    }
+%[4]s
+  }
+}
+`, data.RandomInteger, scopeIDs[0], scopeIDs[1], preAuthorizedApplication)
+}
+
 func (ApplicationResource) oauth2PermissionScopesUpdate(data acceptance.TestData, scopeIDs []string) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -1250,6 +1358,28 @@ resource "azuread_application" "duplicate" {
 `, r.basic(data))
 }
 
+func (ApplicationResource) identifierUri(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name    = "acctest-APP-%[1]d"
+  identifier_uris = ["api://acctest-APP-%[1]d"]
+}
+`, data.RandomInteger)
+}
+
+func (r ApplicationResource) duplicateIdentifierUri(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_application" "duplicate" {
+  display_name    = "acctest-APP-duplicate-%[2]d"
+  identifier_uris = azuread_application.test.identifier_uris
+}
+`, r.identifierUri(data), data.RandomInteger)
+}
+
 func (ApplicationResource) related(data acceptance.TestData, uuids []string) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/tokenissuancepolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/policyassignments"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func applicationTokenIssuancePolicyAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: applicationTokenIssuancePolicyAssignmentResourceCreate,
+		ReadContext:   applicationTokenIssuancePolicyAssignmentResourceRead,
+		DeleteContext: applicationTokenIssuancePolicyAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateApplicationIdTokenIssuancePolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"application_id": {
+				Description:  "ID of the application for which to assign the policy",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidateApplicationID,
+			},
+
+			"token_issuance_policy_id": {
+				Description:  "ID of the token issuance policy to assign",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidatePolicyTokenIssuancePolicyID,
+			},
+		},
+	}
+}
+
+func applicationTokenIssuancePolicyAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.TokenIssuancePolicyClient
+
+	applicationId, err := stable.ParseApplicationID(d.Get("application_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "application_id", "Parsing `application_id`")
+	}
+
+	policyId, err := stable.ParsePolicyTokenIssuancePolicyID(d.Get("token_issuance_policy_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "token_issuance_policy_id", "Parsing `token_issuance_policy_id`")
+	}
+
+	id := stable.NewApplicationIdTokenIssuancePolicyID(applicationId.ApplicationId, policyId.TokenIssuancePolicyId)
+
+	tf.LockByName(applicationResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+	if diags := applicationTokenIssuancePolicyAssignment(client, id).Create(ctx, d, "azuread_application_token_issuance_policy_assignment", client.Client.BaseUri, func(ctx context.Context, ref stable.ReferenceCreate) error {
+		_, err := client.AddTokenIssuancePolicyRef(ctx, *applicationId, ref, tokenissuancepolicy.DefaultAddTokenIssuancePolicyRefOperationOptions())
+		return err
+	}); diags.HasError() {
+		return diags
+	}
+
+	return applicationTokenIssuancePolicyAssignmentResourceRead(ctx, d, meta)
+}
+
+func applicationTokenIssuancePolicyAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.TokenIssuancePolicyClient
+
+	id, err := stable.ParseApplicationIdTokenIssuancePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Token Issuance Policy Assignment ID %q", d.Id())
+	}
+
+	policyId := stable.NewPolicyTokenIssuancePolicyID(id.TokenIssuancePolicyId)
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	exists, err := applicationTokenIssuancePolicyAssignment(client, *id).Exists(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "listing Token Issuance Policy Assignments for %s", applicationId)
+	}
+	if exists == nil || !*exists {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "application_id", applicationId.ID())
+	tf.Set(d, "token_issuance_policy_id", policyId.ID())
+
+	return nil
+}
+
+func applicationTokenIssuancePolicyAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.TokenIssuancePolicyClient
+
+	id, err := stable.ParseApplicationIdTokenIssuancePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Token Issuance Policy Assignment ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+	return applicationTokenIssuancePolicyAssignment(client, *id).Delete(ctx, func(ctx context.Context) (*http.Response, error) {
+		resp, err := client.RemoveTokenIssuancePolicyRef(ctx, *id, tokenissuancepolicy.DefaultRemoveTokenIssuancePolicyRefOperationOptions())
+		return resp.HttpResponse, err
+	})
+}
+
+// applicationTokenIssuancePolicyAssignment describes the assignment of a token issuance policy to an application
+func applicationTokenIssuancePolicyAssignment(client *tokenissuancepolicy.TokenIssuancePolicyClient, id stable.ApplicationIdTokenIssuancePolicyId) policyassignments.Assignment[stable.TokenIssuancePolicy] {
+	return policyassignments.Assignment[stable.TokenIssuancePolicy]{
+		ID:       &id,
+		PolicyId: id.TokenIssuancePolicyId,
+		List: func(ctx context.Context) (*http.Response, *[]stable.TokenIssuancePolicy, error) {
+			resp, err := client.ListTokenIssuancePolicies(ctx, stable.NewApplicationID(id.ApplicationId), tokenissuancepolicy.DefaultListTokenIssuancePoliciesOperationOptions())
+			return resp.HttpResponse, resp.Model, err
+		},
+	}
+}
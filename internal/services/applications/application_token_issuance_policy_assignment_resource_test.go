@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/tokenissuancepolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ApplicationTokenIssuancePolicyAssignmentResource struct{}
+
+func TestTokenIssuancePolicyAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_application_token_issuance_policy_assignment", "test")
+	r := ApplicationTokenIssuancePolicyAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ApplicationTokenIssuancePolicyAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Applications.TokenIssuancePolicyClient
+
+	id, err := stable.ParseApplicationIdTokenIssuancePolicyID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Token Issuance Policy Assignment ID: %v", err)
+	}
+
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	resp, err := client.ListTokenIssuancePolicies(ctx, applicationId, tokenissuancepolicy.DefaultListTokenIssuancePoliciesOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s does not exist", applicationId)
+		}
+		return nil, fmt.Errorf("failed to retrieve token issuance policy assignments for %s: %+v", applicationId, err)
+	}
+
+	if resp.Model != nil {
+		for _, p := range *resp.Model {
+			if strings.EqualFold(pointer.From(p.Id), id.TokenIssuancePolicyId) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (ApplicationTokenIssuancePolicyAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_token_issuance_policy" "test" {
+  definition = [
+    "{\"TokenIssuancePolicy\":{\"Version\":1,\"SigningAlgorithm\":\"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256\",\"SamlTokenVersion\":\"2.0\",\"TokenResponseSigningPolicy\":\"TokenOnly\"}}"
+  ]
+  display_name = "acctest-%[1]s"
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctest-TIP-%[1]s"
+}
+
+resource "azuread_application_token_issuance_policy_assignment" "test" {
+  application_id           = azuread_application.test.id
+  token_issuance_policy_id = azuread_token_issuance_policy.test.id
+}
+`, data.RandomString)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/tokenlifetimepolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/policyassignments"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func applicationTokenLifetimePolicyAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: applicationTokenLifetimePolicyAssignmentResourceCreate,
+		ReadContext:   applicationTokenLifetimePolicyAssignmentResourceRead,
+		DeleteContext: applicationTokenLifetimePolicyAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateApplicationIdTokenLifetimePolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"application_id": {
+				Description:  "ID of the application for which to assign the policy",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidateApplicationID,
+			},
+
+			"token_lifetime_policy_id": {
+				Description:  "ID of the token lifetime policy to assign",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidatePolicyTokenLifetimePolicyID,
+			},
+		},
+	}
+}
+
+func applicationTokenLifetimePolicyAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.TokenLifetimePolicyClient
+
+	applicationId, err := stable.ParseApplicationID(d.Get("application_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "application_id", "Parsing `application_id`")
+	}
+
+	policyId, err := stable.ParsePolicyTokenLifetimePolicyID(d.Get("token_lifetime_policy_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "token_lifetime_policy_id", "Parsing `token_lifetime_policy_id`")
+	}
+
+	id := stable.NewApplicationIdTokenLifetimePolicyID(applicationId.ApplicationId, policyId.TokenLifetimePolicyId)
+
+	tf.LockByName(applicationResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+	if diags := applicationTokenLifetimePolicyAssignment(client, id).Create(ctx, d, "azuread_application_token_lifetime_policy_assignment", client.Client.BaseUri, func(ctx context.Context, ref stable.ReferenceCreate) error {
+		_, err := client.AddTokenLifetimePolicyRef(ctx, *applicationId, ref, tokenlifetimepolicy.DefaultAddTokenLifetimePolicyRefOperationOptions())
+		return err
+	}); diags.HasError() {
+		return diags
+	}
+
+	return applicationTokenLifetimePolicyAssignmentResourceRead(ctx, d, meta)
+}
+
+func applicationTokenLifetimePolicyAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.TokenLifetimePolicyClient
+
+	id, err := stable.ParseApplicationIdTokenLifetimePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Token Lifetime Policy Assignment ID %q", d.Id())
+	}
+
+	policyId := stable.NewPolicyTokenLifetimePolicyID(id.TokenLifetimePolicyId)
+	applicationId := stable.NewApplicationID(id.ApplicationId)
+
+	exists, err := applicationTokenLifetimePolicyAssignment(client, *id).Exists(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "listing Token Lifetime Policy Assignments for %s", applicationId)
+	}
+	if exists == nil || !*exists {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "application_id", applicationId.ID())
+	tf.Set(d, "token_lifetime_policy_id", policyId.ID())
+
+	return nil
+}
+
+func applicationTokenLifetimePolicyAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Applications.TokenLifetimePolicyClient
+
+	id, err := stable.ParseApplicationIdTokenLifetimePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Token Lifetime Policy Assignment ID %q", d.Id())
+	}
+
+	tf.LockByName(applicationResourceName, id.ApplicationId)
+	defer tf.UnlockByName(applicationResourceName, id.ApplicationId)
+
+	return applicationTokenLifetimePolicyAssignment(client, *id).Delete(ctx, func(ctx context.Context) (*http.Response, error) {
+		resp, err := client.RemoveTokenLifetimePolicyRef(ctx, *id, tokenlifetimepolicy.DefaultRemoveTokenLifetimePolicyRefOperationOptions())
+		return resp.HttpResponse, err
+	})
+}
+
+// applicationTokenLifetimePolicyAssignment describes the assignment of a token lifetime policy to an application
+func applicationTokenLifetimePolicyAssignment(client *tokenlifetimepolicy.TokenLifetimePolicyClient, id stable.ApplicationIdTokenLifetimePolicyId) policyassignments.Assignment[stable.TokenLifetimePolicy] {
+	return policyassignments.Assignment[stable.TokenLifetimePolicy]{
+		ID:       &id,
+		PolicyId: id.TokenLifetimePolicyId,
+		List: func(ctx context.Context) (*http.Response, *[]stable.TokenLifetimePolicy, error) {
+			resp, err := client.ListTokenLifetimePolicies(ctx, stable.NewApplicationID(id.ApplicationId), tokenlifetimepolicy.DefaultListTokenLifetimePoliciesOperationOptions())
+			return resp.HttpResponse, resp.Model, err
+		},
+	}
+}
@@ -312,6 +312,70 @@ func applicationFindByName(ctx context.Context, client *application.ApplicationC
 	return &result, nil
 }
 
+// applicationCheckIdentifierUrisAvailable returns an error naming the conflicting application when any of the provided
+// identifier URIs is already in use by an application other than the one with the specified object ID. The Graph API
+// otherwise returns an opaque error when attempting to use an identifier URI which is not unique.
+func applicationCheckIdentifierUrisAvailable(ctx context.Context, client *application.ApplicationClient, identifierUris []string, objectId string) error {
+	for _, identifierUri := range identifierUris {
+		options := application.ListApplicationsOperationOptions{
+			Filter: pointer.To(fmt.Sprintf("identifierUris/any(x:x eq '%s')", odata.EscapeSingleQuote(identifierUri))),
+			Select: &[]string{"appId", "displayName", "id", "identifierUris"},
+		}
+		resp, err := client.ListApplications(ctx, options)
+		if err != nil {
+			return fmt.Errorf("unable to list Applications with filter %q: %+v", *options.Filter, err)
+		}
+
+		if apps := resp.Model; apps != nil {
+			for _, app := range *apps {
+				if strings.EqualFold(pointer.From(app.Id), objectId) {
+					continue
+				}
+				for _, existingUri := range pointer.From(app.IdentifierUris) {
+					if strings.EqualFold(existingUri, identifierUri) {
+						return fmt.Errorf("the identifier URI %q is already in use by the application %q (object ID: %q, client ID: %q)",
+							identifierUri, app.DisplayName.GetOrZero(), pointer.From(app.Id), app.AppId.GetOrZero())
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// applicationEarliestCredentialEndDate returns the earliest end date of all password and key credentials for the
+// application, formatted as RFC3339, or an empty string when the application has no credentials with an end date
+func applicationEarliestCredentialEndDate(app stable.Application) string {
+	endDates := make([]string, 0)
+	for _, credential := range pointer.From(app.PasswordCredentials) {
+		endDates = append(endDates, credential.EndDateTime.GetOrZero())
+	}
+	for _, credential := range pointer.From(app.KeyCredentials) {
+		endDates = append(endDates, credential.EndDateTime.GetOrZero())
+	}
+
+	var earliest *time.Time
+	for _, v := range endDates {
+		if v == "" {
+			continue
+		}
+		endDate, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			continue
+		}
+		if earliest == nil || endDate.Before(*earliest) {
+			earliest = &endDate
+		}
+	}
+
+	if earliest == nil {
+		return ""
+	}
+
+	return earliest.Format(time.RFC3339)
+}
+
 func applicationParseLogoImage(encodedImage string) (string, []byte, error) {
 	imageData, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedImage))
 	if err != nil {
@@ -575,6 +639,24 @@ func expandApplicationOptionalClaim(in []interface{}) *[]stable.OptionalClaim {
 	return &result
 }
 
+func expandApplicationPreAuthorizedApplications(in []interface{}) *[]stable.PreAuthorizedApplication {
+	result := make([]stable.PreAuthorizedApplication, 0)
+
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		preAuthorizedApplication := raw.(map[string]interface{})
+
+		result = append(result, stable.PreAuthorizedApplication{
+			AppId:                  nullable.Value(preAuthorizedApplication["authorized_client_id"].(string)),
+			DelegatedPermissionIds: tf.ExpandStringSlicePtr(preAuthorizedApplication["permission_ids"].(*pluginsdk.Set).List()),
+		})
+	}
+
+	return &result
+}
+
 func expandApplicationPublicClient(input []interface{}) (result *stable.PublicClientApplication) {
 	result = &stable.PublicClientApplication{
 		RedirectUris: &[]string{},
@@ -672,8 +754,10 @@ func flattenApplicationApi(in *stable.ApiApplication, dataSource bool) []map[str
 	mappedClaims := in.AcceptMappedClaims.GetOrZero()
 
 	scopesKey := "oauth2_permission_scope"
+	preAuthorizedKey := "pre_authorized_application"
 	if dataSource {
 		scopesKey = "oauth2_permission_scopes"
+		preAuthorizedKey = "pre_authorized_applications"
 	}
 
 	accessTokenVersion := 1
@@ -685,10 +769,27 @@ func flattenApplicationApi(in *stable.ApiApplication, dataSource bool) []map[str
 		"known_client_applications":      tf.FlattenStringSlicePtr(in.KnownClientApplications),
 		"mapped_claims_enabled":          mappedClaims,
 		scopesKey:                        applications.FlattenOAuth2PermissionScopes(in.OAuth2PermissionScopes),
+		preAuthorizedKey:                 flattenApplicationPreAuthorizedApplications(in.PreAuthorizedApplications),
 		"requested_access_token_version": accessTokenVersion,
 	}}
 }
 
+func flattenApplicationPreAuthorizedApplications(in *[]stable.PreAuthorizedApplication) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, app := range *in {
+		result = append(result, map[string]interface{}{
+			"authorized_client_id": app.AppId.GetOrZero(),
+			"permission_ids":       tf.FlattenStringSlicePtr(app.DelegatedPermissionIds),
+		})
+	}
+
+	return result
+}
+
 func flattenApplicationGroupMembershipClaims(in nullable.Type[string]) []interface{} {
 	if in.IsNull() {
 		return []interface{}{}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applications
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+func TestApplicationEarliestCredentialEndDate(t *testing.T) {
+	cases := []struct {
+		Name     string
+		App      stable.Application
+		Expected string
+	}{
+		{
+			Name:     "no credentials",
+			App:      stable.Application{},
+			Expected: "",
+		},
+		{
+			Name: "passwords only",
+			App: stable.Application{
+				PasswordCredentials: pointer.To([]stable.PasswordCredential{
+					{EndDateTime: nullable.Value("2030-06-01T00:00:00Z")},
+					{EndDateTime: nullable.Value("2029-01-15T12:30:00Z")},
+				}),
+			},
+			Expected: "2029-01-15T12:30:00Z",
+		},
+		{
+			Name: "key credential expires first",
+			App: stable.Application{
+				PasswordCredentials: pointer.To([]stable.PasswordCredential{
+					{EndDateTime: nullable.Value("2030-06-01T00:00:00Z")},
+				}),
+				KeyCredentials: pointer.To([]stable.KeyCredential{
+					{EndDateTime: nullable.Value("2028-03-01T00:00:00Z")},
+				}),
+			},
+			Expected: "2028-03-01T00:00:00Z",
+		},
+		{
+			Name: "credentials without end dates are ignored",
+			App: stable.Application{
+				PasswordCredentials: pointer.To([]stable.PasswordCredential{
+					{},
+					{EndDateTime: nullable.Value("not a date")},
+					{EndDateTime: nullable.Value("2031-01-01T00:00:00Z")},
+				}),
+			},
+			Expected: "2031-01-01T00:00:00Z",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if result := applicationEarliestCredentialEndDate(tc.App); result != tc.Expected {
+				t.Fatalf("Expected applicationEarliestCredentialEndDate() to return %q, got %q", tc.Expected, result)
+			}
+		})
+	}
+}
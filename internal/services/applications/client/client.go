@@ -6,9 +6,12 @@ package client
 import (
 	applicationBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/beta/application"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/application"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/appmanagementpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/federatedidentitycredential"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/logo"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/owner"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/tokenissuancepolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applications/stable/tokenlifetimepolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/applicationtemplates/stable/applicationtemplate"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
@@ -22,7 +25,10 @@ type Client struct {
 	ApplicationOwnerClient                 *owner.OwnerClient
 	ApplicationFederatedIdentityCredential *federatedidentitycredential.FederatedIdentityCredentialClient
 	ApplicationTemplateClient              *applicationtemplate.ApplicationTemplateClient
+	AppManagementPolicyClient              *appmanagementpolicy.AppManagementPolicyClient
 	ServicePrincipalClient                 *serviceprincipal.ServicePrincipalClient
+	TokenIssuancePolicyClient              *tokenissuancepolicy.TokenIssuancePolicyClient
+	TokenLifetimePolicyClient              *tokenlifetimepolicy.TokenLifetimePolicyClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -63,6 +69,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(applicationTemplateClient.Client)
 
+	appManagementPolicyClient, err := appmanagementpolicy.NewAppManagementPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(appManagementPolicyClient.Client)
+
 	directoryObjectClient, err := directoryobject.NewDirectoryObjectClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -75,6 +87,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(servicePrincipalClient.Client)
 
+	tokenIssuancePolicyClient, err := tokenissuancepolicy.NewTokenIssuancePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(tokenIssuancePolicyClient.Client)
+
+	tokenLifetimePolicyClient, err := tokenlifetimepolicy.NewTokenLifetimePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(tokenLifetimePolicyClient.Client)
+
 	return &Client{
 		ApplicationClient:                      applicationClient,
 		ApplicationClientBeta:                  applicationClientBeta,
@@ -82,6 +106,9 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ApplicationOwnerClient:                 applicationOwnerClient,
 		ApplicationFederatedIdentityCredential: applicationFederatedIdentityCredentialClient,
 		ApplicationTemplateClient:              applicationTemplateClient,
+		AppManagementPolicyClient:              appManagementPolicyClient,
 		ServicePrincipalClient:                 servicePrincipalClient,
+		TokenIssuancePolicyClient:              tokenIssuancePolicyClient,
+		TokenLifetimePolicyClient:              tokenLifetimePolicyClient,
 	}, nil
 }
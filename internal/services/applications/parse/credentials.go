@@ -6,14 +6,17 @@ package parse
 import (
 	"fmt"
 	"strings"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
 )
 
 // TODO: Remove this legacy ID in v3.0
 
 type CredentialId struct {
-	ObjectId string
-	KeyType  string
-	KeyId    string
+	ObjectId   string
+	KeyType    string
+	KeyId      string
+	Thumbprint string
 }
 
 func NewCredentialID(objectId, keyType, keyId string) CredentialId {
@@ -29,6 +32,19 @@ func (id CredentialId) String() string {
 }
 
 func CertificateID(idString string) (*CredentialId, error) {
+	if credentials.IsCertificateThumbprintID(idString) {
+		objectId, thumbprint, err := credentials.ParseCertificateThumbprintID(idString)
+		if err != nil {
+			return nil, err
+		}
+
+		return &CredentialId{
+			ObjectId:   objectId,
+			KeyType:    "certificate",
+			Thumbprint: thumbprint,
+		}, nil
+	}
+
 	id, err := ObjectSubResourceID(idString, "certificate")
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse Certificate ID: %v", err)
@@ -30,20 +30,24 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_application":                   applicationDataSource(),
-		"azuread_application_published_app_ids": applicationPublishedAppIdsDataSource(),
-		"azuread_application_template":          applicationTemplateDataSource(),
+		"azuread_application":                               applicationDataSource(),
+		"azuread_application_federated_identity_credential": applicationFederatedIdentityCredentialDataSource(),
+		"azuread_application_published_app_ids":             applicationPublishedAppIdsDataSource(),
+		"azuread_application_template":                      applicationTemplateDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_application":                               applicationResource(),
-		"azuread_application_certificate":                   applicationCertificateResource(),
-		"azuread_application_federated_identity_credential": applicationFederatedIdentityCredentialResource(),
-		"azuread_application_password":                      applicationPasswordResource(),
-		"azuread_application_pre_authorized":                applicationPreAuthorizedResource(),
+		"azuread_application": applicationResource(),
+		"azuread_application_app_management_policy_assignment": applicationAppManagementPolicyAssignmentResource(),
+		"azuread_application_certificate":                      applicationCertificateResource(),
+		"azuread_application_federated_identity_credential":    applicationFederatedIdentityCredentialResource(),
+		"azuread_application_password":                         applicationPasswordResource(),
+		"azuread_application_pre_authorized":                   applicationPreAuthorizedResource(),
+		"azuread_application_token_issuance_policy_assignment": applicationTokenIssuancePolicyAssignmentResource(),
+		"azuread_application_token_lifetime_policy_assignment": applicationTokenLifetimePolicyAssignmentResource(),
 	}
 }
 
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// LogoImageMaxSize is the maximum size in bytes of an application logo image accepted by Azure Active Directory
+const LogoImageMaxSize = 100 * 1024
+
+var logoImageContentTypes = []string{"image/gif", "image/jpeg", "image/png"}
+
+// LogoImage checks whether a value is a base64 encoded gif, jpeg or png image, which is no larger than the maximum size
+// supported for application logos.
+func LogoImage(i interface{}, path cty.Path) (ret pluginsdk.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	imageData, err := base64.StdEncoding.DecodeString(strings.TrimSpace(v))
+	if err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Value must be a base64 encoded image",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+		return
+	}
+
+	if contentType := http.DetectContentType(imageData); !slices.Contains(logoImageContentTypes, contentType) {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Logo image must be in gif, jpeg or png format",
+			Detail:        fmt.Sprintf("The detected content type was %q", contentType),
+			AttributePath: path,
+		})
+	}
+
+	if len(imageData) > LogoImageMaxSize {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Logo image must be no larger than %d KB", LogoImageMaxSize/1024),
+			Detail:        fmt.Sprintf("The decoded image is %d bytes", len(imageData)),
+			AttributePath: path,
+		})
+	}
+
+	return // nolint:nakedret
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestLogoImage(t *testing.T) {
+	pngHeader := []byte("\x89PNG\x0D\x0A\x1A\x0A")
+	jpegHeader := []byte("\xFF\xD8\xFF\xE0")
+	gifHeader := []byte("GIF89a")
+
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    base64.StdEncoding.EncodeToString(pngHeader),
+			TestName: "Valid_Png",
+			ErrCount: 0,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString(jpegHeader),
+			TestName: "Valid_Jpeg",
+			ErrCount: 0,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString(gifHeader),
+			TestName: "Valid_Gif",
+			ErrCount: 0,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString(append(pngHeader, make([]byte, LogoImageMaxSize-len(pngHeader))...)),
+			TestName: "Valid_MaxSize",
+			ErrCount: 0,
+		},
+		{
+			Value:    "not base64!",
+			TestName: "Invalid_NotBase64",
+			ErrCount: 1,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>")),
+			TestName: "Invalid_ContentType",
+			ErrCount: 1,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString(append(pngHeader, bytes.Repeat([]byte{0}, LogoImageMaxSize)...)),
+			TestName: "Invalid_TooLarge",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := LogoImage(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected LogoImage to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}
@@ -9,20 +9,24 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/migrations"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/parse"
 )
 
 func appRoleAssignmentResource() *pluginsdk.Resource {
@@ -37,7 +41,12 @@ func appRoleAssignmentResource() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			if !strings.HasPrefix(id, "/") {
+				// Composite IDs are resolved to the app role assignment ID after validation
+				_, err := parse.AppRoleAssignmentCompositeID(id)
+				return err
+			}
 			if _, errs := stable.ValidateServicePrincipalIdAppRoleAssignedToID(id, "id"); len(errs) > 0 {
 				out := ""
 				for _, err := range errs {
@@ -46,7 +55,7 @@ func appRoleAssignmentResource() *pluginsdk.Resource {
 				return fmt.Errorf(out)
 			}
 			return nil
-		}),
+		}, appRoleAssignmentResourceImport),
 
 		SchemaVersion: 1,
 		StateUpgraders: []pluginsdk.StateUpgrader{
@@ -105,19 +114,71 @@ func appRoleAssignmentResource() *pluginsdk.Resource {
 
 func appRoleAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).AppRoleAssignments.AppRoleAssignedToClient
+	directoryObjectClient := meta.(*clients.Client).AppRoleAssignments.DirectoryObjectClient
 	servicePrincipalClient := meta.(*clients.Client).AppRoleAssignments.ServicePrincipalClient
 
 	appRoleId := d.Get("app_role_id").(string)
 	principalId := d.Get("principal_object_id").(string)
 	resourceId := d.Get("resource_object_id").(string)
 
-	if resp, err := servicePrincipalClient.GetServicePrincipal(ctx, stable.NewServicePrincipalID(resourceId), serviceprincipal.DefaultGetServicePrincipalOperationOptions()); err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
-			return tf.ErrorDiagPathF(err, "principal_object_id", "Service principal not found for resource (Object ID: %q)", resourceId)
+	servicePrincipalResp, err := servicePrincipalClient.GetServicePrincipal(ctx, stable.NewServicePrincipalID(resourceId), serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		if response.WasNotFound(servicePrincipalResp.HttpResponse) {
+			return tf.ErrorDiagPathF(err, "resource_object_id", "Service principal not found for resource (Object ID: %q)", resourceId)
 		}
 		return tf.ErrorDiagF(err, "Could not retrieve service principal for resource (Object ID: %q)", resourceId)
 	}
 
+	principalDirectoryObjectId := stable.NewDirectoryObjectID(principalId)
+	principalResp, err := directoryObjectClient.GetDirectoryObject(ctx, principalDirectoryObjectId, directoryobject.DefaultGetDirectoryObjectOperationOptions())
+	if err != nil && response.WasNotFound(principalResp.HttpResponse) {
+		// The principal may have only just been created, so wait for it to be replicated, bounded by the create timeout
+		if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+			resp, err := directoryObjectClient.GetDirectoryObject(ctx, principalDirectoryObjectId, directoryobject.DefaultGetDirectoryObjectOperationOptions())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return pointer.To(false), nil
+				} else if response.WasForbidden(resp.HttpResponse) {
+					return pointer.To(true), nil
+				}
+				return nil, err
+			}
+			return pointer.To(true), nil
+		}); err != nil {
+			return tf.ErrorDiagPathF(err, "principal_object_id", "Principal not found (Object ID: %q)", principalId)
+		}
+
+		principalResp, err = directoryObjectClient.GetDirectoryObject(ctx, principalDirectoryObjectId, directoryobject.DefaultGetDirectoryObjectOperationOptions())
+	}
+
+	if err != nil {
+		// The calling principal may not be permitted to read the principal, in which case the principal type cannot be
+		// validated here and any invalid assignment is rejected by the API instead
+		if !response.WasForbidden(principalResp.HttpResponse) {
+			if response.WasNotFound(principalResp.HttpResponse) {
+				return tf.ErrorDiagPathF(err, "principal_object_id", "Principal not found (Object ID: %q)", principalId)
+			}
+			return tf.ErrorDiagF(err, "Could not retrieve principal (Object ID: %q)", principalId)
+		}
+		log.Printf("[DEBUG] Not permitted to retrieve principal (Object ID: %q), skipping validation of principal type", principalId)
+	} else {
+		if principalResp.Model == nil {
+			return tf.ErrorDiagF(errors.New("model was nil"), "Could not retrieve principal (Object ID: %q)", principalId)
+		}
+
+		odataType := pointer.From(principalResp.Model.DirectoryObject().ODataType)
+		principalType := appRoleAssignmentPrincipalType(odataType)
+		if principalType == "" {
+			return tf.ErrorDiagPathF(fmt.Errorf("principal has unsupported object type %q", odataType), "principal_object_id", "App roles can only be assigned to users, groups or service principals")
+		}
+
+		if servicePrincipal := servicePrincipalResp.Model; servicePrincipal != nil {
+			if err = appRoleAssignmentValidatePrincipalType(servicePrincipal.AppRoles, appRoleId, principalType); err != nil {
+				return tf.ErrorDiagPathF(err, "app_role_id", "App role cannot be assigned to %s (Object ID: %q)", strings.ToLower(principalType), principalId)
+			}
+		}
+	}
+
 	properties := stable.AppRoleAssignment{
 		AppRoleId:   pointer.To(appRoleId),
 		PrincipalId: nullable.Value(principalId),
@@ -192,6 +253,37 @@ func appRoleAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceDat
 	return nil
 }
 
+func appRoleAssignmentResourceImport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).AppRoleAssignments.AppRoleAssignedToClient
+
+	if strings.HasPrefix(d.Id(), "/") {
+		return []*pluginsdk.ResourceData{d}, nil
+	}
+
+	compositeId, err := parse.AppRoleAssignmentCompositeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// The app role assignment ID is not derivable, so look for an assignment matching the principal and app role
+	resp, err := client.ListAppRoleAssignedTosComplete(ctx, stable.NewServicePrincipalID(compositeId.ResourceId), approleassignedto.DefaultListAppRoleAssignedTosOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("listing app role assignments for resource service principal %q: %v", compositeId.ResourceId, err)
+	}
+
+	for _, assignment := range resp.Items {
+		if strings.EqualFold(assignment.PrincipalId.GetOrZero(), compositeId.PrincipalId) && strings.EqualFold(pointer.From(assignment.AppRoleId), compositeId.AppRoleId) {
+			if pointer.From(assignment.Id) == "" {
+				return nil, errors.New("ID returned for app role assignment is nil")
+			}
+			d.SetId(stable.NewServicePrincipalIdAppRoleAssignedToID(compositeId.ResourceId, *assignment.Id).ID())
+			return []*pluginsdk.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no app role assignment was found for principal %q with app role %q on resource service principal %q", compositeId.PrincipalId, compositeId.AppRoleId, compositeId.ResourceId)
+}
+
 func appRoleAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).AppRoleAssignments.AppRoleAssignedToClient
 
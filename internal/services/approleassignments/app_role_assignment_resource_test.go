@@ -6,16 +6,19 @@ package approleassignments_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/approleassignments/parse"
 )
 
 type AppRoleAssignmentResource struct{}
@@ -32,6 +35,12 @@ func TestAccAppRoleAssignment_servicePrincipalForMsGraph(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
+		{
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: r.compositeImportId(data),
+		},
 	})
 }
 
@@ -60,12 +69,25 @@ func TestAccAppRoleAssignment_groupForTenantApp(t *testing.T) {
 			Config: r.groupForTenantApp(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_type").HasValue("Group"),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
+func TestAccAppRoleAssignment_groupForTenantAppRoleNotAllowed(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_role_assignment", "test")
+	r := AppRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.groupForTenantAppRoleNotAllowed(data),
+			ExpectError: regexp.MustCompile("App role cannot be assigned to group"),
+		},
+	})
+}
+
 func TestAccAppRoleAssignment_groupForTenantAppWithoutRole(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_app_role_assignment", "test")
 	r := AppRoleAssignmentResource{}
@@ -96,6 +118,17 @@ func TestAccAppRoleAssignment_userForTenantApp(t *testing.T) {
 	})
 }
 
+func (AppRoleAssignmentResource) compositeImportId(data acceptance.TestData) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", data.ResourceName)
+		}
+
+		return parse.NewAppRoleAssignmentCompositeID(rs.Primary.Attributes["resource_object_id"], rs.Primary.Attributes["principal_object_id"], rs.Primary.Attributes["app_role_id"]).String(), nil
+	}
+}
+
 func (r AppRoleAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.AppRoleAssignments.AppRoleAssignedToClient
 
@@ -250,6 +283,23 @@ resource "azuread_app_role_assignment" "test" {
 `, r.tenantAppTemplate(data), data.RandomInteger)
 }
 
+func (r AppRoleAssignmentResource) groupForTenantAppRoleNotAllowed(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group" "test" {
+  display_name     = "acctest-appRoleAssignment-%[2]d"
+  security_enabled = true
+}
+
+resource "azuread_app_role_assignment" "test" {
+  app_role_id         = azuread_service_principal.internal.app_role_ids["Query.All"]
+  principal_object_id = azuread_group.test.object_id
+  resource_object_id  = azuread_service_principal.internal.object_id
+}
+`, r.tenantAppTemplate(data), data.RandomInteger)
+}
+
 func (r AppRoleAssignmentResource) groupForTenantAppWithoutRole(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+)
+
+// defaultAccessAppRoleId is the ID used to assign a principal to a resource that does not declare any app roles
+const defaultAccessAppRoleId = "00000000-0000-0000-0000-000000000000"
+
+// appRoleAssignmentPrincipalType returns the principal type for an OData type, or an empty string when the object
+// cannot be assigned an app role
+func appRoleAssignmentPrincipalType(odataType string) string {
+	switch strings.TrimPrefix(odataType, "#microsoft.graph.") {
+	case "user":
+		return "User"
+	case "group":
+		return "Group"
+	case "servicePrincipal":
+		return "ServicePrincipal"
+	}
+	return ""
+}
+
+// appRoleAssignmentValidatePrincipalType checks that the app role with ID `appRoleId` can be assigned to a principal
+// of type `principalType`. Users and groups can be assigned app roles that allow the `User` member type, whilst
+// service principals can be assigned app roles that allow the `Application` member type. When the app role cannot be
+// found, validation is deferred to the API.
+func appRoleAssignmentValidatePrincipalType(appRoles *[]stable.AppRole, appRoleId, principalType string) error {
+	if appRoleId == defaultAccessAppRoleId || appRoles == nil {
+		return nil
+	}
+
+	requiredMemberType := "User"
+	if principalType == "ServicePrincipal" {
+		requiredMemberType = "Application"
+	}
+
+	for _, appRole := range *appRoles {
+		if !strings.EqualFold(pointer.From(appRole.Id), appRoleId) {
+			continue
+		}
+
+		allowedMemberTypes := pointer.From(appRole.AllowedMemberTypes)
+		for _, memberType := range allowedMemberTypes {
+			if strings.EqualFold(memberType, requiredMemberType) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("app role %q (%s) only allows assignment to member types [%s] and cannot be assigned to a %s, which requires the %q member type", appRoleId, appRole.Value.GetOrZero(), strings.Join(allowedMemberTypes, ", "), strings.ToLower(principalType), requiredMemberType)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package approleassignments
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+)
+
+func TestAppRoleAssignmentValidatePrincipalType(t *testing.T) {
+	appRoles := &[]stable.AppRole{
+		{
+			Id:                 pointer.To("11111111-1111-1111-1111-111111111111"),
+			AllowedMemberTypes: &[]string{"Application", "User"},
+		},
+		{
+			Id:                 pointer.To("22222222-2222-2222-2222-222222222222"),
+			AllowedMemberTypes: &[]string{"Application"},
+		},
+		{
+			Id:                 pointer.To("33333333-3333-3333-3333-333333333333"),
+			AllowedMemberTypes: &[]string{"User"},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		appRoleId     string
+		principalType string
+		expectError   bool
+	}{
+		{name: "group allowed", appRoleId: "11111111-1111-1111-1111-111111111111", principalType: "Group"},
+		{name: "user allowed", appRoleId: "33333333-3333-3333-3333-333333333333", principalType: "User"},
+		{name: "service principal allowed", appRoleId: "22222222-2222-2222-2222-222222222222", principalType: "ServicePrincipal"},
+		{name: "group not allowed", appRoleId: "22222222-2222-2222-2222-222222222222", principalType: "Group", expectError: true},
+		{name: "service principal not allowed", appRoleId: "33333333-3333-3333-3333-333333333333", principalType: "ServicePrincipal", expectError: true},
+		{name: "default access", appRoleId: defaultAccessAppRoleId, principalType: "Group"},
+		{name: "unknown app role", appRoleId: "44444444-4444-4444-4444-444444444444", principalType: "Group"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := appRoleAssignmentValidatePrincipalType(appRoles, tc.appRoleId, tc.principalType)
+			if tc.expectError && err == nil {
+				t.Fatalf("expected an error, got none")
+			}
+			if !tc.expectError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestAppRoleAssignmentPrincipalType(t *testing.T) {
+	for input, expected := range map[string]string{
+		"#microsoft.graph.user":             "User",
+		"#microsoft.graph.group":            "Group",
+		"#microsoft.graph.servicePrincipal": "ServicePrincipal",
+		"#microsoft.graph.device":           "",
+	} {
+		if actual := appRoleAssignmentPrincipalType(input); actual != expected {
+			t.Fatalf("expected %q for %q, got %q", expected, input, actual)
+		}
+	}
+}
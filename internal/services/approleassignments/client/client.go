@@ -4,6 +4,7 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/approleassignedto"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
@@ -11,6 +12,7 @@ import (
 
 type Client struct {
 	AppRoleAssignedToClient *approleassignedto.AppRoleAssignedToClient
+	DirectoryObjectClient   *directoryobject.DirectoryObjectClient
 	ServicePrincipalClient  *serviceprincipal.ServicePrincipalClient
 }
 
@@ -21,6 +23,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(appRoleAssignedToClient.Client)
 
+	directoryObjectClient, err := directoryobject.NewDirectoryObjectClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(directoryObjectClient.Client)
+
 	servicePrincipalClient, err := serviceprincipal.NewServicePrincipalClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -29,6 +37,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 
 	return &Client{
 		AppRoleAssignedToClient: appRoleAssignedToClient,
+		DirectoryObjectClient:   directoryObjectClient,
 		ServicePrincipalClient:  servicePrincipalClient,
 	}, nil
 }
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+// AppRoleAssignmentCompositeId identifies an app role assignment by the resource service principal, the assigned
+// principal and the app role, and is accepted when importing an app role assignment whose ID is not known.
+type AppRoleAssignmentCompositeId struct {
+	ResourceId  string
+	PrincipalId string
+	AppRoleId   string
+}
+
+func NewAppRoleAssignmentCompositeID(resourceId, principalId, appRoleId string) AppRoleAssignmentCompositeId {
+	return AppRoleAssignmentCompositeId{
+		ResourceId:  resourceId,
+		PrincipalId: principalId,
+		AppRoleId:   appRoleId,
+	}
+}
+
+func (id AppRoleAssignmentCompositeId) String() string {
+	return fmt.Sprintf("%s/principal/%s/appRole/%s", id.ResourceId, id.PrincipalId, id.AppRoleId)
+}
+
+// AppRoleAssignmentCompositeID parses an ID in the format {resourceObjectId}/principal/{principalObjectId}/appRole/{appRoleId}
+func AppRoleAssignmentCompositeID(idString string) (*AppRoleAssignmentCompositeId, error) {
+	parts := strings.Split(idString, "/")
+	if len(parts) != 5 || parts[1] != "principal" || parts[3] != "appRole" {
+		return nil, fmt.Errorf("App Role Assignment composite ID should be in the format {resourceObjectId}/principal/{principalObjectId}/appRole/{appRoleId} - but got %q", idString)
+	}
+
+	id := NewAppRoleAssignmentCompositeID(parts[0], parts[2], parts[4])
+
+	if _, err := uuid.ParseUUID(id.ResourceId); err != nil {
+		return nil, fmt.Errorf("Resource Object ID isn't a valid UUID (%q): %+v", id.ResourceId, err)
+	}
+	if _, err := uuid.ParseUUID(id.PrincipalId); err != nil {
+		return nil, fmt.Errorf("Principal Object ID isn't a valid UUID (%q): %+v", id.PrincipalId, err)
+	}
+	if _, err := uuid.ParseUUID(id.AppRoleId); err != nil {
+		return nil, fmt.Errorf("App Role ID isn't a valid UUID (%q): %+v", id.AppRoleId, err)
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlogs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// auditLogsPager is a custom pager which stops following `@odata.nextLink` once at least `limit` entries have been
+// retrieved, since audit log collections can be very large and the SDK list methods always retrieve every page
+type auditLogsPager struct {
+	NextLink *odata.Link       `json:"@odata.nextLink"`
+	Values   []json.RawMessage `json:"value"`
+
+	limit int
+	count int
+}
+
+func (p *auditLogsPager) NextPageLink() *odata.Link {
+	defer func() {
+		p.NextLink = nil
+		p.Values = nil
+	}()
+
+	p.count += len(p.Values)
+	if p.count >= p.limit {
+		return nil
+	}
+
+	return p.NextLink
+}
+
+// listAuditLogs retrieves audit log entries from the specified collection, requesting further pages only until at
+// least `limit` entries have been retrieved, and unmarshals the combined `value` into `model`
+func listAuditLogs(ctx context.Context, c *msgraph.Client, path string, options client.Options, retryFunc client.RequestRetryFunc, limit int, model interface{}) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Pager:         &auditLogsPager{limit: limit},
+		Path:          path,
+		RetryFunc:     retryFunc,
+	}
+
+	req, err := c.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.ExecutePaged(ctx)
+	if err != nil {
+		return err
+	}
+
+	return resp.Unmarshal(model)
+}
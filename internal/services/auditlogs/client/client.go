@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	signinBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/auditlogs/beta/signin"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/auditlogs/stable/directoryaudit"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/auditlogs/stable/signin"
+	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+)
+
+type Client struct {
+	DirectoryAuditClient *directoryaudit.DirectoryAuditClient
+	SignInClient         *signin.SignInClient
+	SignInClientBeta     *signinBeta.SignInClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	directoryAuditClient, err := directoryaudit.NewDirectoryAuditClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(directoryAuditClient.Client)
+
+	signInClient, err := signin.NewSignInClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(signInClient.Client)
+
+	// Non-interactive, service principal and managed identity sign-ins are only returned by the beta API
+	signInClientBeta, err := signinBeta.NewSignInClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(signInClientBeta.Client)
+
+	return &Client{
+		DirectoryAuditClient: directoryAuditClient,
+		SignInClient:         signInClient,
+		SignInClientBeta:     signInClientBeta,
+	}, nil
+}
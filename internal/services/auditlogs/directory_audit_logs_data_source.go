@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlogs
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/auditlogs/stable/directoryaudit"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type DirectoryAuditLogsId string
+
+func (id DirectoryAuditLogsId) ID() string {
+	return string(id)
+}
+
+func (DirectoryAuditLogsId) String() string {
+	return "Directory Audit Logs"
+}
+
+type DirectoryAuditLogsDataSourceModel struct {
+	ActivityDisplayName          string              `tfschema:"activity_display_name"`
+	AuditLogs                    []DirectoryAuditLog `tfschema:"audit_logs"`
+	Category                     string              `tfschema:"category"`
+	EndDateTime                  string              `tfschema:"end_date_time"`
+	Filter                       string              `tfschema:"filter"`
+	InitiatedByAppId             string              `tfschema:"initiated_by_app_id"`
+	InitiatedByUserId            string              `tfschema:"initiated_by_user_id"`
+	InitiatedByUserPrincipalName string              `tfschema:"initiated_by_user_principal_name"`
+	MaxResults                   int                 `tfschema:"max_results"`
+	Result                       string              `tfschema:"result"`
+	StartDateTime                string              `tfschema:"start_date_time"`
+}
+
+type DirectoryAuditLog struct {
+	ActivityDateTime             string                    `tfschema:"activity_date_time"`
+	ActivityDisplayName          string                    `tfschema:"activity_display_name"`
+	Category                     string                    `tfschema:"category"`
+	CorrelationId                string                    `tfschema:"correlation_id"`
+	Id                           string                    `tfschema:"id"`
+	InitiatedByAppDisplayName    string                    `tfschema:"initiated_by_app_display_name"`
+	InitiatedByAppId             string                    `tfschema:"initiated_by_app_id"`
+	InitiatedByUserId            string                    `tfschema:"initiated_by_user_id"`
+	InitiatedByUserPrincipalName string                    `tfschema:"initiated_by_user_principal_name"`
+	LoggedByService              string                    `tfschema:"logged_by_service"`
+	OperationType                string                    `tfschema:"operation_type"`
+	Result                       string                    `tfschema:"result"`
+	ResultReason                 string                    `tfschema:"result_reason"`
+	TargetResources              []DirectoryAuditLogTarget `tfschema:"target_resources"`
+}
+
+type DirectoryAuditLogTarget struct {
+	DisplayName       string `tfschema:"display_name"`
+	Id                string `tfschema:"id"`
+	Type              string `tfschema:"type"`
+	UserPrincipalName string `tfschema:"user_principal_name"`
+}
+
+type DirectoryAuditLogsDataSource struct{}
+
+var _ sdk.DataSource = DirectoryAuditLogsDataSource{}
+
+func (r DirectoryAuditLogsDataSource) ResourceType() string {
+	return "azuread_directory_audit_logs"
+}
+
+func (r DirectoryAuditLogsDataSource) ModelObject() interface{} {
+	return &DirectoryAuditLogsDataSourceModel{}
+}
+
+func (r DirectoryAuditLogsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"activity_display_name": {
+			Description:  "Only return entries for the activity with this name, e.g. `Add member to group`",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"category": {
+			Description:  "Only return entries in this category, e.g. `UserManagement` or `GroupManagement`",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"end_date_time": {
+			Description:  "Only return entries for activities performed at or before this time, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`)",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"filter": {
+			Description:  "An additional OData filter expression, which is combined with any other specified criteria",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"initiated_by_app_id": {
+			Description:   "Only return entries for activities initiated by the application with this client ID",
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.IsUUID,
+			ConflictsWith: []string{"initiated_by_user_id", "initiated_by_user_principal_name"},
+		},
+
+		"initiated_by_user_id": {
+			Description:   "Only return entries for activities initiated by the user with this object ID",
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.IsUUID,
+			ConflictsWith: []string{"initiated_by_app_id", "initiated_by_user_principal_name"},
+		},
+
+		"initiated_by_user_principal_name": {
+			Description:   "Only return entries for activities initiated by the user with this user principal name",
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"initiated_by_app_id", "initiated_by_user_id"},
+		},
+
+		"max_results": {
+			Description:  "The maximum number of entries to return, most recent first",
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+
+		"result": {
+			Description:  "Only return entries with this result",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForOperationResult(), false),
+		},
+
+		"start_date_time": {
+			Description:  "Only return entries for activities performed at or after this time, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`)",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+	}
+}
+
+func (r DirectoryAuditLogsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"audit_logs": {
+			Description: "A list of directory audit log entries, most recent first",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"activity_date_time": {
+						Description: "The date and time the activity was performed",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"activity_display_name": {
+						Description: "The name of the activity",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"category": {
+						Description: "The category of resource targeted by the activity",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"correlation_id": {
+						Description: "An ID which can be used to correlate activities across services",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"id": {
+						Description: "The ID of the audit log entry",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"initiated_by_app_display_name": {
+						Description: "The display name of the application that initiated the activity",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"initiated_by_app_id": {
+						Description: "The client ID of the application that initiated the activity",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"initiated_by_user_id": {
+						Description: "The object ID of the user that initiated the activity",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"initiated_by_user_principal_name": {
+						Description: "The user principal name of the user that initiated the activity",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"logged_by_service": {
+						Description: "The service that logged the activity",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"operation_type": {
+						Description: "The type of operation that was performed",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"result": {
+						Description: "The result of the activity",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"result_reason": {
+						Description: "The reason for failure, when the activity did not succeed",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"target_resources": {
+						Description: "A list of resources that were changed by the activity",
+						Type:        pluginsdk.TypeList,
+						Computed:    true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"display_name": {
+									Description: "The display name of the resource",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"id": {
+									Description: "The ID of the resource",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"type": {
+									Description: "The type of the resource",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"user_principal_name": {
+									Description: "The user principal name, when the resource is a user",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DirectoryAuditLogsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AuditLogs.DirectoryAuditClient
+			tenantId := metadata.Client.TenantID
+
+			var state DirectoryAuditLogsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// The API returns at most 999 entries per page, further pages are only requested until `max_results` entries
+			// have been retrieved
+			options := directoryaudit.ListDirectoryAuditsOperationOptions{
+				OrderBy: pointer.To(odata.OrderBy{
+					Field:     "activityDateTime",
+					Direction: odata.Descending,
+				}),
+				Top: pointer.To(int64(min(state.MaxResults, 999))),
+			}
+
+			filter := directoryAuditLogsFilter(state)
+			if filter != "" {
+				options.Filter = pointer.To(filter)
+			}
+
+			var result struct {
+				Values *[]stable.DirectoryAudit `json:"value"`
+			}
+			if err := listAuditLogs(ctx, client.Client, "/auditLogs/directoryAudits", options, options.RetryFunc, state.MaxResults, &result); err != nil {
+				return fmt.Errorf("listing directory audit logs: %+v", err)
+			}
+
+			state.AuditLogs = make([]DirectoryAuditLog, 0)
+			if result.Values != nil {
+				for _, v := range *result.Values {
+					if len(state.AuditLogs) >= state.MaxResults {
+						break
+					}
+					state.AuditLogs = append(state.AuditLogs, flattenDirectoryAuditLog(v))
+				}
+			}
+
+			// Generate a unique ID based on the query
+			h := sha1.New()
+			if _, err := h.Write([]byte(fmt.Sprintf("%s/%d", filter, state.MaxResults))); err != nil {
+				return fmt.Errorf("unable to compute hash for directory audit log query: %+v", err)
+			}
+
+			metadata.SetID(DirectoryAuditLogsId(fmt.Sprintf("directoryAuditLogs#%s#%s", tenantId, base64.URLEncoding.EncodeToString(h.Sum(nil)))))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// directoryAuditLogsFilter builds an OData filter expression from the criteria specified in the data source
+// configuration, returning an empty string when no criteria are specified
+func directoryAuditLogsFilter(state DirectoryAuditLogsDataSourceModel) string {
+	filters := make([]string, 0)
+
+	if state.StartDateTime != "" {
+		filters = append(filters, fmt.Sprintf("activityDateTime ge %s", state.StartDateTime))
+	}
+	if state.EndDateTime != "" {
+		filters = append(filters, fmt.Sprintf("activityDateTime le %s", state.EndDateTime))
+	}
+	if state.ActivityDisplayName != "" {
+		filters = append(filters, fmt.Sprintf("activityDisplayName eq '%s'", odata.EscapeSingleQuote(state.ActivityDisplayName)))
+	}
+	if state.Category != "" {
+		filters = append(filters, fmt.Sprintf("category eq '%s'", odata.EscapeSingleQuote(state.Category)))
+	}
+	if state.Result != "" {
+		filters = append(filters, fmt.Sprintf("result eq '%s'", odata.EscapeSingleQuote(state.Result)))
+	}
+	if state.InitiatedByAppId != "" {
+		filters = append(filters, fmt.Sprintf("initiatedBy/app/appId eq '%s'", odata.EscapeSingleQuote(state.InitiatedByAppId)))
+	}
+	if state.InitiatedByUserId != "" {
+		filters = append(filters, fmt.Sprintf("initiatedBy/user/id eq '%s'", odata.EscapeSingleQuote(state.InitiatedByUserId)))
+	}
+	if state.InitiatedByUserPrincipalName != "" {
+		filters = append(filters, fmt.Sprintf("initiatedBy/user/userPrincipalName eq '%s'", odata.EscapeSingleQuote(state.InitiatedByUserPrincipalName)))
+	}
+	if state.Filter != "" {
+		filters = append(filters, fmt.Sprintf("(%s)", state.Filter))
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+func flattenDirectoryAuditLog(in stable.DirectoryAudit) DirectoryAuditLog {
+	result := DirectoryAuditLog{
+		ActivityDateTime:    pointer.From(in.ActivityDateTime),
+		ActivityDisplayName: pointer.From(in.ActivityDisplayName),
+		Category:            pointer.From(in.Category),
+		CorrelationId:       in.CorrelationId.GetOrZero(),
+		Id:                  pointer.From(in.Id),
+		LoggedByService:     in.LoggedByService.GetOrZero(),
+		OperationType:       in.OperationType.GetOrZero(),
+		Result:              string(pointer.From(in.Result)),
+		ResultReason:        in.ResultReason.GetOrZero(),
+		TargetResources:     make([]DirectoryAuditLogTarget, 0),
+	}
+
+	if in.InitiatedBy != nil {
+		if app := in.InitiatedBy.App; app != nil {
+			result.InitiatedByAppDisplayName = app.DisplayName.GetOrZero()
+			result.InitiatedByAppId = app.AppId.GetOrZero()
+		}
+		if user := in.InitiatedBy.User; user != nil {
+			result.InitiatedByUserId = user.Id.GetOrZero()
+			result.InitiatedByUserPrincipalName = user.UserPrincipalName.GetOrZero()
+		}
+	}
+
+	if in.TargetResources != nil {
+		for _, target := range *in.TargetResources {
+			result.TargetResources = append(result.TargetResources, DirectoryAuditLogTarget{
+				DisplayName:       target.DisplayName.GetOrZero(),
+				Id:                target.Id.GetOrZero(),
+				Type:              target.Type.GetOrZero(),
+				UserPrincipalName: target.UserPrincipalName.GetOrZero(),
+			})
+		}
+	}
+
+	return result
+}
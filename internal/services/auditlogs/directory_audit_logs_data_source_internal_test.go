@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlogs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/auditlogs/stable/directoryaudit"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+func TestDirectoryAuditLogsFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		state    DirectoryAuditLogsDataSourceModel
+		expected string
+	}{
+		{
+			name:     "none",
+			state:    DirectoryAuditLogsDataSourceModel{},
+			expected: "",
+		},
+		{
+			name: "dateRange",
+			state: DirectoryAuditLogsDataSourceModel{
+				StartDateTime: "2024-01-01T00:00:00Z",
+				EndDateTime:   "2024-01-31T23:59:59Z",
+			},
+			expected: "activityDateTime ge 2024-01-01T00:00:00Z and activityDateTime le 2024-01-31T23:59:59Z",
+		},
+		{
+			name: "activityAndInitiator",
+			state: DirectoryAuditLogsDataSourceModel{
+				ActivityDisplayName:          "Add member to group",
+				InitiatedByUserPrincipalName: "o'brien@example.com",
+			},
+			expected: "activityDisplayName eq 'Add member to group' and initiatedBy/user/userPrincipalName eq 'o''brien@example.com'",
+		},
+		{
+			name: "customFilter",
+			state: DirectoryAuditLogsDataSourceModel{
+				Category: "UserManagement",
+				Filter:   "loggedByService eq 'Core Directory' or result eq 'failure'",
+				Result:   "success",
+			},
+			expected: "category eq 'UserManagement' and result eq 'success' and (loggedByService eq 'Core Directory' or result eq 'failure')",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := directoryAuditLogsFilter(tc.state); actual != tc.expected {
+				t.Fatalf("expected filter %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestListAuditLogsStopsPagingAtLimit(t *testing.T) {
+	const pageSize, totalPages = 2, 5
+
+	testCases := []struct {
+		name             string
+		limit            int
+		expectedRequests int
+		expectedEntries  int
+	}{
+		{
+			name:             "firstPage",
+			limit:            2,
+			expectedRequests: 1,
+			expectedEntries:  2,
+		},
+		{
+			name:             "partialPage",
+			limit:            3,
+			expectedRequests: 2,
+			expectedEntries:  4,
+		},
+		{
+			name:             "allPages",
+			limit:            100,
+			expectedRequests: totalPages,
+			expectedEntries:  pageSize * totalPages,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := 0
+
+			var server *httptest.Server
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requests++
+				mu.Unlock()
+
+				page := 0
+				if v := r.URL.Query().Get("page"); v != "" {
+					page, _ = strconv.Atoi(v)
+				}
+
+				body := `{"value":[`
+				for i := 0; i < pageSize; i++ {
+					if i > 0 {
+						body += ","
+					}
+					body += fmt.Sprintf(`{"id":"entry-%d-%d"}`, page, i)
+				}
+				body += "]"
+				if page < totalPages-1 {
+					body += fmt.Sprintf(`,"@odata.nextLink":"%s/v1.0/auditLogs/directoryAudits?page=%d"`, server.URL, page+1)
+				}
+				body += "}"
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, _ = w.Write([]byte(body))
+			}))
+			defer server.Close()
+
+			client, err := directoryaudit.NewDirectoryAuditClientWithBaseURI(environments.NewApiEndpoint("MicrosoftGraph", server.URL, nil))
+			if err != nil {
+				t.Fatalf("building client: %+v", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			options := directoryaudit.ListDirectoryAuditsOperationOptions{
+				Top: pointer.To(int64(pageSize)),
+			}
+
+			var result struct {
+				Values *[]stable.DirectoryAudit `json:"value"`
+			}
+			if err := listAuditLogs(ctx, client.Client, "/auditLogs/directoryAudits", options, options.RetryFunc, tc.limit, &result); err != nil {
+				t.Fatalf("listing audit logs: %+v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if requests != tc.expectedRequests {
+				t.Fatalf("expected %d requests, got %d", tc.expectedRequests, requests)
+			}
+			if result.Values == nil || len(*result.Values) != tc.expectedEntries {
+				t.Fatalf("expected %d entries, got %+v", tc.expectedEntries, result.Values)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlogs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryAuditLogsDataSource struct{}
+
+func TestAccDirectoryAuditLogsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_audit_logs", "test")
+	r := DirectoryAuditLogsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("audit_logs.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryAuditLogsDataSource_activity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_audit_logs", "test")
+	r := DirectoryAuditLogsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.activity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("audit_logs.#").Exists(),
+				check.That(data.ResourceName).Key("audit_logs.0.activity_display_name").HasValue("Add group"),
+				check.That(data.ResourceName).Key("audit_logs.0.category").HasValue("GroupManagement"),
+			),
+		},
+	})
+}
+
+func (DirectoryAuditLogsDataSource) basic() string {
+	return `
+provider "azuread" {}
+
+data "azuread_directory_audit_logs" "test" {
+  max_results = 5
+}
+`
+}
+
+func (DirectoryAuditLogsDataSource) activity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+data "azuread_directory_audit_logs" "test" {
+  activity_display_name = "Add group"
+  category              = "GroupManagement"
+  start_date_time       = timeadd(plantimestamp(), "-24h")
+  max_results           = 10
+
+  depends_on = [azuread_group.test]
+}
+`, data.RandomInteger)
+}
@@ -0,0 +1,605 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlogs
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	signinBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/auditlogs/beta/signin"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/auditlogs/stable/signin"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
+)
+
+type SignInLogsId string
+
+func (id SignInLogsId) ID() string {
+	return string(id)
+}
+
+func (SignInLogsId) String() string {
+	return "Sign-In Logs"
+}
+
+type SignInLogsDataSourceModel struct {
+	AppId                   string      `tfschema:"app_id"`
+	ConditionalAccessStatus string      `tfschema:"conditional_access_status"`
+	EndDateTime             string      `tfschema:"end_date_time"`
+	Filter                  string      `tfschema:"filter"`
+	IncludeNonInteractive   bool        `tfschema:"include_non_interactive"`
+	MaxResults              int         `tfschema:"max_results"`
+	RiskLevelDuringSignIn   string      `tfschema:"risk_level_during_sign_in"`
+	SignIns                 []SignInLog `tfschema:"sign_ins"`
+	StartDateTime           string      `tfschema:"start_date_time"`
+	UserId                  string      `tfschema:"user_id"`
+	UserPrincipalName       string      `tfschema:"user_principal_name"`
+}
+
+type SignInLog struct {
+	AppDisplayName                   string                             `tfschema:"app_display_name"`
+	AppId                            string                             `tfschema:"app_id"`
+	AppliedConditionalAccessPolicies []SignInLogConditionalAccessPolicy `tfschema:"applied_conditional_access_policies"`
+	ClientAppUsed                    string                             `tfschema:"client_app_used"`
+	ConditionalAccessStatus          string                             `tfschema:"conditional_access_status"`
+	CorrelationId                    string                             `tfschema:"correlation_id"`
+	CreatedDateTime                  string                             `tfschema:"created_date_time"`
+	Id                               string                             `tfschema:"id"`
+	IPAddress                        string                             `tfschema:"ip_address"`
+	Interactive                      bool                               `tfschema:"interactive"`
+	Location                         []SignInLogLocation                `tfschema:"location"`
+	ResourceDisplayName              string                             `tfschema:"resource_display_name"`
+	RiskDetail                       string                             `tfschema:"risk_detail"`
+	RiskLevelAggregated              string                             `tfschema:"risk_level_aggregated"`
+	RiskLevelDuringSignIn            string                             `tfschema:"risk_level_during_sign_in"`
+	RiskState                        string                             `tfschema:"risk_state"`
+	Status                           []SignInLogStatus                  `tfschema:"status"`
+	UserDisplayName                  string                             `tfschema:"user_display_name"`
+	UserId                           string                             `tfschema:"user_id"`
+	UserPrincipalName                string                             `tfschema:"user_principal_name"`
+}
+
+type SignInLogConditionalAccessPolicy struct {
+	DisplayName string `tfschema:"display_name"`
+	Id          string `tfschema:"id"`
+	Result      string `tfschema:"result"`
+}
+
+type SignInLogLocation struct {
+	City            string `tfschema:"city"`
+	CountryOrRegion string `tfschema:"country_or_region"`
+	State           string `tfschema:"state"`
+}
+
+type SignInLogStatus struct {
+	AdditionalDetails string `tfschema:"additional_details"`
+	ErrorCode         int    `tfschema:"error_code"`
+	FailureReason     string `tfschema:"failure_reason"`
+}
+
+type SignInLogsDataSource struct{}
+
+var _ sdk.DataSource = SignInLogsDataSource{}
+
+func (r SignInLogsDataSource) ResourceType() string {
+	return "azuread_sign_in_logs"
+}
+
+func (r SignInLogsDataSource) ModelObject() interface{} {
+	return &SignInLogsDataSourceModel{}
+}
+
+func (r SignInLogsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"app_id": {
+			Description:  "Only return sign-ins to the application with this client ID",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"conditional_access_status": {
+			Description:  "Only return sign-ins with this conditional access status",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForConditionalAccessStatus(), false),
+		},
+
+		"end_date_time": {
+			Description:  "Only return sign-ins that occurred at or before this time, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`)",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"filter": {
+			Description:  "An additional OData filter expression, which is combined with any other specified criteria",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"include_non_interactive": {
+			Description: "Whether to include non-interactive user sign-ins, in addition to interactive user sign-ins",
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+
+		"max_results": {
+			Description:  "The maximum number of sign-ins to return, most recent first",
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: validation.IntBetween(1, 10000),
+		},
+
+		"risk_level_during_sign_in": {
+			Description:  "Only return sign-ins with this risk level",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(stable.PossibleValuesForRiskLevel(), false),
+		},
+
+		"start_date_time": {
+			Description:  "Only return sign-ins that occurred at or after this time, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`)",
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"user_id": {
+			Description:   "Only return sign-ins by the user with this object ID",
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.IsUUID,
+			ConflictsWith: []string{"user_principal_name"},
+		},
+
+		"user_principal_name": {
+			Description:   "Only return sign-ins by the user with this user principal name",
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"user_id"},
+		},
+	}
+}
+
+func (r SignInLogsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"sign_ins": {
+			Description: "A list of sign-ins, most recent first",
+			Type:        pluginsdk.TypeList,
+			Computed:    true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"app_display_name": {
+						Description: "The display name of the application signed in to",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"app_id": {
+						Description: "The client ID of the application signed in to",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"applied_conditional_access_policies": {
+						Description: "A list of conditional access policies evaluated for the sign-in",
+						Type:        pluginsdk.TypeList,
+						Computed:    true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"display_name": {
+									Description: "The display name of the conditional access policy",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"id": {
+									Description: "The ID of the conditional access policy",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"result": {
+									Description: "The result of the conditional access policy evaluation",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+							},
+						},
+					},
+
+					"client_app_used": {
+						Description: "The legacy client used for the sign-in",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"conditional_access_status": {
+						Description: "The status of the conditional access policies evaluated for the sign-in",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"correlation_id": {
+						Description: "An ID which can be used to correlate the sign-in with other activities",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"created_date_time": {
+						Description: "The date and time of the sign-in",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"id": {
+						Description: "The ID of the sign-in",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"interactive": {
+						Description: "Whether the sign-in was interactive",
+						Type:        pluginsdk.TypeBool,
+						Computed:    true,
+					},
+
+					"ip_address": {
+						Description: "The IP address of the client used for the sign-in",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"location": {
+						Description: "The location of the sign-in",
+						Type:        pluginsdk.TypeList,
+						Computed:    true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"city": {
+									Description: "The city of the sign-in",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"country_or_region": {
+									Description: "The two letter country or region code of the sign-in",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"state": {
+									Description: "The state of the sign-in",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+							},
+						},
+					},
+
+					"resource_display_name": {
+						Description: "The display name of the resource signed in to",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"risk_detail": {
+						Description: "The reason behind the risk state of the user",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"risk_level_aggregated": {
+						Description: "The aggregated risk level of the sign-in",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"risk_level_during_sign_in": {
+						Description: "The risk level detected during the sign-in",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"risk_state": {
+						Description: "The risk state of the user",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"status": {
+						Description: "The status of the sign-in",
+						Type:        pluginsdk.TypeList,
+						Computed:    true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"additional_details": {
+									Description: "Additional details about the sign-in status",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+
+								"error_code": {
+									Description: "The error code of the sign-in, `0` when the sign-in was successful",
+									Type:        pluginsdk.TypeInt,
+									Computed:    true,
+								},
+
+								"failure_reason": {
+									Description: "The reason the sign-in failed",
+									Type:        pluginsdk.TypeString,
+									Computed:    true,
+								},
+							},
+						},
+					},
+
+					"user_display_name": {
+						Description: "The display name of the user",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"user_id": {
+						Description: "The object ID of the user",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+
+					"user_principal_name": {
+						Description: "The user principal name of the user",
+						Type:        pluginsdk.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r SignInLogsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AuditLogs.SignInClient
+			clientBeta := metadata.Client.AuditLogs.SignInClientBeta
+			tenantId := metadata.Client.TenantID
+
+			var state SignInLogsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			orderBy := odata.OrderBy{
+				Field:     "createdDateTime",
+				Direction: odata.Descending,
+			}
+
+			// The API returns at most 1000 entries per page, further pages are only requested until `max_results` entries
+			// have been retrieved
+			top := int64(min(state.MaxResults, 1000))
+
+			filter := signInLogsFilter(state)
+
+			state.SignIns = make([]SignInLog, 0)
+
+			// The stable API only returns interactive user sign-ins, other sign-in event types are only available
+			// from the beta API
+			if state.IncludeNonInteractive {
+				options := signinBeta.ListSignInsOperationOptions{
+					Filter:  pointer.To(filter),
+					OrderBy: pointer.To(orderBy),
+					Top:     pointer.To(top),
+				}
+
+				var result struct {
+					Values *[]beta.SignIn `json:"value"`
+				}
+				if err := listAuditLogs(ctx, clientBeta.Client, "/auditLogs/signIns", options, options.RetryFunc, state.MaxResults, &result); err != nil {
+					return fmt.Errorf("listing sign-in logs: %+v", err)
+				}
+
+				if result.Values != nil {
+					for _, v := range *result.Values {
+						if len(state.SignIns) >= state.MaxResults {
+							break
+						}
+						state.SignIns = append(state.SignIns, flattenSignInLogBeta(v))
+					}
+				}
+			} else {
+				options := signin.ListSignInsOperationOptions{
+					OrderBy: pointer.To(orderBy),
+					Top:     pointer.To(top),
+				}
+				if filter != "" {
+					options.Filter = pointer.To(filter)
+				}
+
+				var result struct {
+					Values *[]stable.SignIn `json:"value"`
+				}
+				if err := listAuditLogs(ctx, client.Client, "/auditLogs/signIns", options, options.RetryFunc, state.MaxResults, &result); err != nil {
+					return fmt.Errorf("listing sign-in logs: %+v", err)
+				}
+
+				if result.Values != nil {
+					for _, v := range *result.Values {
+						if len(state.SignIns) >= state.MaxResults {
+							break
+						}
+						state.SignIns = append(state.SignIns, flattenSignInLog(v))
+					}
+				}
+			}
+
+			// Generate a unique ID based on the query
+			h := sha1.New()
+			if _, err := h.Write([]byte(fmt.Sprintf("%s/%d", filter, state.MaxResults))); err != nil {
+				return fmt.Errorf("unable to compute hash for sign-in log query: %+v", err)
+			}
+
+			metadata.SetID(SignInLogsId(fmt.Sprintf("signInLogs#%s#%s", tenantId, base64.URLEncoding.EncodeToString(h.Sum(nil)))))
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// signInLogsFilter builds an OData filter expression from the criteria specified in the data source configuration,
+// returning an empty string when no criteria are specified
+func signInLogsFilter(state SignInLogsDataSourceModel) string {
+	filters := make([]string, 0)
+
+	if state.StartDateTime != "" {
+		filters = append(filters, fmt.Sprintf("createdDateTime ge %s", state.StartDateTime))
+	}
+	if state.EndDateTime != "" {
+		filters = append(filters, fmt.Sprintf("createdDateTime le %s", state.EndDateTime))
+	}
+	if state.UserId != "" {
+		filters = append(filters, fmt.Sprintf("userId eq '%s'", odata.EscapeSingleQuote(state.UserId)))
+	}
+	if state.UserPrincipalName != "" {
+		filters = append(filters, fmt.Sprintf("userPrincipalName eq '%s'", odata.EscapeSingleQuote(state.UserPrincipalName)))
+	}
+	if state.AppId != "" {
+		filters = append(filters, fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(state.AppId)))
+	}
+	if state.ConditionalAccessStatus != "" {
+		filters = append(filters, fmt.Sprintf("conditionalAccessStatus eq '%s'", odata.EscapeSingleQuote(state.ConditionalAccessStatus)))
+	}
+	if state.RiskLevelDuringSignIn != "" {
+		filters = append(filters, fmt.Sprintf("riskLevelDuringSignIn eq '%s'", odata.EscapeSingleQuote(state.RiskLevelDuringSignIn)))
+	}
+	if state.IncludeNonInteractive {
+		// Without this filter, the beta API only returns interactive user sign-ins
+		filters = append(filters, "signInEventTypes/any(t: t eq 'interactiveUser' or t eq 'nonInteractiveUser')")
+	}
+	if state.Filter != "" {
+		filters = append(filters, fmt.Sprintf("(%s)", state.Filter))
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+func flattenSignInLog(in stable.SignIn) SignInLog {
+	result := SignInLog{
+		AppDisplayName:                   in.AppDisplayName.GetOrZero(),
+		AppId:                            in.AppId.GetOrZero(),
+		AppliedConditionalAccessPolicies: make([]SignInLogConditionalAccessPolicy, 0),
+		ClientAppUsed:                    in.ClientAppUsed.GetOrZero(),
+		ConditionalAccessStatus:          string(pointer.From(in.ConditionalAccessStatus)),
+		CorrelationId:                    in.CorrelationId.GetOrZero(),
+		CreatedDateTime:                  pointer.From(in.CreatedDateTime),
+		Id:                               pointer.From(in.Id),
+		IPAddress:                        in.IPAddress.GetOrZero(),
+		Interactive:                      in.IsInteractive.GetOrZero(),
+		Location:                         make([]SignInLogLocation, 0),
+		ResourceDisplayName:              in.ResourceDisplayName.GetOrZero(),
+		RiskDetail:                       string(pointer.From(in.RiskDetail)),
+		RiskLevelAggregated:              string(pointer.From(in.RiskLevelAggregated)),
+		RiskLevelDuringSignIn:            string(pointer.From(in.RiskLevelDuringSignIn)),
+		RiskState:                        string(pointer.From(in.RiskState)),
+		Status:                           make([]SignInLogStatus, 0),
+		UserDisplayName:                  in.UserDisplayName.GetOrZero(),
+		UserId:                           pointer.From(in.UserId),
+		UserPrincipalName:                in.UserPrincipalName.GetOrZero(),
+	}
+
+	if in.AppliedConditionalAccessPolicies != nil {
+		for _, policy := range *in.AppliedConditionalAccessPolicies {
+			result.AppliedConditionalAccessPolicies = append(result.AppliedConditionalAccessPolicies, SignInLogConditionalAccessPolicy{
+				DisplayName: policy.DisplayName.GetOrZero(),
+				Id:          policy.Id.GetOrZero(),
+				Result:      string(pointer.From(policy.Result)),
+			})
+		}
+	}
+
+	if location := in.Location; location != nil {
+		result.Location = append(result.Location, SignInLogLocation{
+			City:            location.City.GetOrZero(),
+			CountryOrRegion: location.CountryOrRegion.GetOrZero(),
+			State:           location.State.GetOrZero(),
+		})
+	}
+
+	if status := in.Status; status != nil {
+		result.Status = append(result.Status, SignInLogStatus{
+			AdditionalDetails: status.AdditionalDetails.GetOrZero(),
+			ErrorCode:         int(status.ErrorCode.GetOrZero()),
+			FailureReason:     status.FailureReason.GetOrZero(),
+		})
+	}
+
+	return result
+}
+
+func flattenSignInLogBeta(in beta.SignIn) SignInLog {
+	result := SignInLog{
+		AppDisplayName:                   in.AppDisplayName.GetOrZero(),
+		AppId:                            in.AppId.GetOrZero(),
+		AppliedConditionalAccessPolicies: make([]SignInLogConditionalAccessPolicy, 0),
+		ClientAppUsed:                    in.ClientAppUsed.GetOrZero(),
+		ConditionalAccessStatus:          string(pointer.From(in.ConditionalAccessStatus)),
+		CorrelationId:                    in.CorrelationId.GetOrZero(),
+		CreatedDateTime:                  pointer.From(in.CreatedDateTime),
+		Id:                               pointer.From(in.Id),
+		IPAddress:                        in.IPAddress.GetOrZero(),
+		Interactive:                      in.IsInteractive.GetOrZero(),
+		Location:                         make([]SignInLogLocation, 0),
+		ResourceDisplayName:              in.ResourceDisplayName.GetOrZero(),
+		RiskDetail:                       string(pointer.From(in.RiskDetail)),
+		RiskLevelAggregated:              string(pointer.From(in.RiskLevelAggregated)),
+		RiskLevelDuringSignIn:            string(pointer.From(in.RiskLevelDuringSignIn)),
+		RiskState:                        string(pointer.From(in.RiskState)),
+		Status:                           make([]SignInLogStatus, 0),
+		UserDisplayName:                  in.UserDisplayName.GetOrZero(),
+		UserId:                           pointer.From(in.UserId),
+		UserPrincipalName:                in.UserPrincipalName.GetOrZero(),
+	}
+
+	if in.AppliedConditionalAccessPolicies != nil {
+		for _, policy := range *in.AppliedConditionalAccessPolicies {
+			result.AppliedConditionalAccessPolicies = append(result.AppliedConditionalAccessPolicies, SignInLogConditionalAccessPolicy{
+				DisplayName: policy.DisplayName.GetOrZero(),
+				Id:          policy.Id.GetOrZero(),
+				Result:      string(pointer.From(policy.Result)),
+			})
+		}
+	}
+
+	if location := in.Location; location != nil {
+		result.Location = append(result.Location, SignInLogLocation{
+			City:            location.City.GetOrZero(),
+			CountryOrRegion: location.CountryOrRegion.GetOrZero(),
+			State:           location.State.GetOrZero(),
+		})
+	}
+
+	if status := in.Status; status != nil {
+		result.Status = append(result.Status, SignInLogStatus{
+			AdditionalDetails: status.AdditionalDetails.GetOrZero(),
+			ErrorCode:         int(status.ErrorCode.GetOrZero()),
+			FailureReason:     status.FailureReason.GetOrZero(),
+		})
+	}
+
+	return result
+}
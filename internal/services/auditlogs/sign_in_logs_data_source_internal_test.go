@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlogs
+
+import "testing"
+
+func TestSignInLogsFilter(t *testing.T) {
+	testCases := []struct {
+		name     string
+		state    SignInLogsDataSourceModel
+		expected string
+	}{
+		{
+			name:     "none",
+			state:    SignInLogsDataSourceModel{},
+			expected: "",
+		},
+		{
+			name: "userAndDateRange",
+			state: SignInLogsDataSourceModel{
+				StartDateTime:     "2024-01-01T00:00:00Z",
+				EndDateTime:       "2024-01-31T23:59:59Z",
+				UserPrincipalName: "o'brien@example.com",
+			},
+			expected: "createdDateTime ge 2024-01-01T00:00:00Z and createdDateTime le 2024-01-31T23:59:59Z and userPrincipalName eq 'o''brien@example.com'",
+		},
+		{
+			name: "riskyNonInteractive",
+			state: SignInLogsDataSourceModel{
+				AppId:                 "00000000-0000-0000-0000-000000000000",
+				IncludeNonInteractive: true,
+				RiskLevelDuringSignIn: "high",
+			},
+			expected: "appId eq '00000000-0000-0000-0000-000000000000' and riskLevelDuringSignIn eq 'high' and signInEventTypes/any(t: t eq 'interactiveUser' or t eq 'nonInteractiveUser')",
+		},
+		{
+			name: "customFilter",
+			state: SignInLogsDataSourceModel{
+				ConditionalAccessStatus: "failure",
+				Filter:                  "status/errorCode ne 0",
+			},
+			expected: "conditionalAccessStatus eq 'failure' and (status/errorCode ne 0)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := signInLogsFilter(tc.state); actual != tc.expected {
+				t.Fatalf("expected filter %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
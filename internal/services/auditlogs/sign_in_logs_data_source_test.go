@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditlogs_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SignInLogsDataSource struct{}
+
+func TestAccSignInLogsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_sign_in_logs", "test")
+	r := SignInLogsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sign_ins.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccSignInLogsDataSource_includeNonInteractive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_sign_in_logs", "test")
+	r := SignInLogsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.includeNonInteractive(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("sign_ins.#").Exists(),
+			),
+		},
+	})
+}
+
+func (SignInLogsDataSource) basic() string {
+	return `
+provider "azuread" {}
+
+data "azuread_sign_in_logs" "test" {
+  start_date_time = timeadd(plantimestamp(), "-24h")
+  max_results     = 5
+}
+`
+}
+
+func (SignInLogsDataSource) includeNonInteractive() string {
+	return `
+provider "azuread" {}
+
+data "azuread_sign_in_logs" "test" {
+  include_non_interactive = true
+  start_date_time         = timeadd(plantimestamp(), "-24h")
+  max_results             = 5
+}
+`
+}
@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessauthenticationcontextclassreference"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func authenticationContextClassReferenceResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: authenticationContextClassReferenceResourceCreate,
+		ReadContext:   authenticationContextClassReferenceResourceRead,
+		UpdateContext: authenticationContextClassReferenceResourceUpdate,
+		DeleteContext: authenticationContextClassReferenceResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateIdentityConditionalAccessAuthenticationContextClassReferenceID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"class_reference_id": {
+				Description:  "The identifier of the authentication context class reference, from `c1` to `c99`",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^c([1-9]|[1-9][0-9])$"), "must be a value from `c1` to `c99`"),
+			},
+
+			"display_name": {
+				Description:  "The display name of the authentication context class reference",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"description": {
+				Description: "A short explanation of the policies that are enforced by the authentication context class reference",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+			},
+
+			"available": {
+				Description: "Whether the authentication context class reference is published and available for use by applications",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func authenticationContextClassReferenceResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationContextClassReferenceClient
+
+	id := stable.NewIdentityConditionalAccessAuthenticationContextClassReferenceID(d.Get("class_reference_id").(string))
+
+	resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing %s", id)
+		}
+	} else {
+		return tf.ImportAsExistsDiag("azuread_authentication_context_class_reference", id.ID())
+	}
+
+	properties := expandAuthenticationContextClassReference(d)
+
+	// Authentication context class references have well-known IDs, so they are created by updating them with the
+	// desired ID
+	if _, err = client.UpdateConditionalAccessAuthenticationContextClassReference(ctx, id, properties, conditionalaccessauthenticationcontextclassreference.DefaultUpdateConditionalAccessAuthenticationContextClassReferenceOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not create %s", id)
+	}
+
+	d.SetId(id.ID())
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return authenticationContextClassReferenceResourceRead(ctx, d, meta)
+}
+
+func authenticationContextClassReferenceResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationContextClassReferenceClient
+
+	id, err := stable.ParseIdentityConditionalAccessAuthenticationContextClassReferenceID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Authentication Context Class Reference ID")
+	}
+
+	properties := expandAuthenticationContextClassReference(d)
+
+	if _, err = client.UpdateConditionalAccessAuthenticationContextClassReference(ctx, *id, properties, conditionalaccessauthenticationcontextclassreference.DefaultUpdateConditionalAccessAuthenticationContextClassReferenceOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update %s", id)
+	}
+
+	return authenticationContextClassReferenceResourceRead(ctx, d, meta)
+}
+
+func authenticationContextClassReferenceResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationContextClassReferenceClient
+
+	id, err := stable.ParseIdentityConditionalAccessAuthenticationContextClassReferenceID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Authentication Context Class Reference ID")
+	}
+
+	resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, *id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	classReference := resp.Model
+	if classReference == nil {
+		return tf.ErrorDiagF(errors.New("returned model was nil"), "Bad API Response")
+	}
+
+	tf.Set(d, "available", classReference.IsAvailable.GetOrZero())
+	tf.Set(d, "class_reference_id", id.AuthenticationContextClassReferenceId)
+	tf.Set(d, "description", classReference.Description.GetOrZero())
+	tf.Set(d, "display_name", classReference.DisplayName.GetOrZero())
+
+	return nil
+}
+
+func authenticationContextClassReferenceResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ConditionalAccess.AuthenticationContextClassReferenceClient
+
+	id, err := stable.ParseIdentityConditionalAccessAuthenticationContextClassReferenceID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Authentication Context Class Reference ID")
+	}
+
+	resp, err := client.DeleteConditionalAccessAuthenticationContextClassReference(ctx, *id, conditionalaccessauthenticationcontextclassreference.DefaultDeleteConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s already deleted", id)
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, *id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+
+			return nil, err
+		}
+
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+func expandAuthenticationContextClassReference(d *pluginsdk.ResourceData) stable.AuthenticationContextClassReference {
+	return stable.AuthenticationContextClassReference{
+		Description: nullable.NoZero(d.Get("description").(string)),
+		DisplayName: nullable.Value(d.Get("display_name").(string)),
+		IsAvailable: nullable.Value(d.Get("available").(bool)),
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessauthenticationcontextclassreference"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AuthenticationContextClassReferenceResource struct{}
+
+func TestAccAuthenticationContextClassReference_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_context_class_reference", "test")
+	r := AuthenticationContextClassReferenceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("available").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAuthenticationContextClassReference_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_authentication_context_class_reference", "test")
+	r := AuthenticationContextClassReferenceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("available").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r AuthenticationContextClassReferenceResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ConditionalAccess.AuthenticationContextClassReferenceClient
+
+	id, err := stable.ParseIdentityConditionalAccessAuthenticationContextClassReferenceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetConditionalAccessAuthenticationContextClassReference(ctx, *id, conditionalaccessauthenticationcontextclassreference.DefaultGetConditionalAccessAuthenticationContextClassReferenceOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+// classReferenceId returns an ID from c1 to c99 for the test case, since the API only permits this range
+func (AuthenticationContextClassReferenceResource) classReferenceId(data acceptance.TestData) string {
+	return fmt.Sprintf("c%d", data.RandomInteger%99+1)
+}
+
+func (r AuthenticationContextClassReferenceResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_authentication_context_class_reference" "test" {
+  class_reference_id = "%[1]s"
+  display_name       = "acctestACCR-%[2]d"
+}
+`, r.classReferenceId(data), data.RandomInteger)
+}
+
+func (r AuthenticationContextClassReferenceResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_authentication_context_class_reference" "test" {
+  class_reference_id = "%[1]s"
+  display_name       = "acctestACCR-%[2]d"
+  description        = "Acceptance test authentication context"
+  available          = true
+}
+`, r.classReferenceId(data), data.RandomInteger)
+}
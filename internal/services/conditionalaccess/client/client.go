@@ -4,6 +4,7 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessauthenticationcontextclassreference"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccessnamedlocation"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesspolicy"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
@@ -18,11 +19,18 @@ import (
 // breaking a policy in this way, is to delete and recreate it, which is wholly undesirable for a critical security resource.
 
 type Client struct {
-	PolicyClient        *conditionalaccesspolicy.ConditionalAccessPolicyClient
-	NamedLocationClient *conditionalaccessnamedlocation.ConditionalAccessNamedLocationClient
+	AuthenticationContextClassReferenceClient *conditionalaccessauthenticationcontextclassreference.ConditionalAccessAuthenticationContextClassReferenceClient
+	PolicyClient                              *conditionalaccesspolicy.ConditionalAccessPolicyClient
+	NamedLocationClient                       *conditionalaccessnamedlocation.ConditionalAccessNamedLocationClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	authenticationContextClassReferenceClient, err := conditionalaccessauthenticationcontextclassreference.NewConditionalAccessAuthenticationContextClassReferenceClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationContextClassReferenceClient.Client)
+
 	policyClient, err := conditionalaccesspolicy.NewConditionalAccessPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -36,6 +44,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(namedLocationClient.Client)
 
 	return &Client{
+		AuthenticationContextClassReferenceClient: authenticationContextClassReferenceClient,
 		PolicyClient:        policyClient,
 		NamedLocationClient: namedLocationClient,
 	}, nil
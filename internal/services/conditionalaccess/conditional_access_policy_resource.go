@@ -319,7 +319,7 @@ func conditionalAccessPolicyResource() *pluginsdk.Resource {
 												"rule": {
 													Type:         pluginsdk.TypeString,
 													Required:     true,
-													ValidateFunc: validation.StringIsNotEmpty,
+													ValidateFunc: validation.StringIsDeviceFilterRule,
 												},
 											},
 										},
@@ -550,6 +550,34 @@ func conditionalAccessPolicyCustomizeDiff(_ context.Context, diff *pluginsdk.Res
 		return fmt.Errorf("when specifying `session_controls` but not `grant_controls`, one of the properties in the `session_controls` block must be set to an effective value in order for session controls to work")
 	}
 
+	if diff.Get("session_controls.#").(int) == 1 {
+		if err := validateConditionalAccessSignInFrequency(diff.Get("session_controls.0.sign_in_frequency").(int), diff.Get("session_controls.0.sign_in_frequency_period").(string), diff.Get("session_controls.0.sign_in_frequency_interval").(string)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateConditionalAccessSignInFrequency checks for combinations of sign-in frequency properties which are rejected by
+// the API. A frequency interval of `everyTime` cannot be combined with a frequency value, and the frequency value must be
+// within the range supported for the chosen period.
+func validateConditionalAccessSignInFrequency(frequency int, period, interval string) error {
+	if interval == string(stable.SignInFrequencyInterval_EveryTime) && (frequency > 0 || period != "") {
+		return fmt.Errorf("`sign_in_frequency` and `sign_in_frequency_period` cannot be specified when `sign_in_frequency_interval` is %q", stable.SignInFrequencyInterval_EveryTime)
+	}
+
+	switch stable.SigninFrequencyType(period) {
+	case stable.SigninFrequencyType_Hours:
+		if frequency < 1 || frequency > 23 {
+			return fmt.Errorf("`sign_in_frequency` must be between 1 and 23 when `sign_in_frequency_period` is %q, got %d", period, frequency)
+		}
+	case stable.SigninFrequencyType_Days:
+		if frequency < 1 || frequency > 365 {
+			return fmt.Errorf("`sign_in_frequency` must be between 1 and 365 when `sign_in_frequency_period` is %q, got %d", period, frequency)
+		}
+	}
+
 	return nil
 }
 
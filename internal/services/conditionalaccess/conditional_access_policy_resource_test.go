@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identity/stable/conditionalaccesspolicy"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
@@ -85,6 +87,40 @@ func TestAccConditionalAccessPolicy_update(t *testing.T) {
 	})
 }
 
+func TestAccConditionalAccessPolicy_stateTransitions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy", "test")
+	r := ConditionalAccessPolicyResource{}
+
+	steps := []acceptance.TestStep{
+		{
+			Config: r.withState(data, "disabled"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue("disabled"),
+			),
+		},
+		data.ImportStep(),
+	}
+
+	// Each transition between states should be applied in-place
+	for _, state := range []string{"enabledForReportingButNotEnforced", "enabled", "disabled", "enabled", "enabledForReportingButNotEnforced", "disabled"} {
+		steps = append(steps, acceptance.TestStep{
+			Config: r.withState(data, state),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(data.ResourceName, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("state").HasValue(state),
+			),
+		}, data.ImportStep())
+	}
+
+	data.ResourceTest(t, r, steps)
+}
+
 func TestAccConditionalAccessPolicy_includedUserActions(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_conditional_access_policy", "test")
 	r := ConditionalAccessPolicyResource{}
@@ -349,13 +385,17 @@ func (r ConditionalAccessPolicyResource) Exists(ctx context.Context, clients *cl
 	return pointer.To(true), nil
 }
 
-func (ConditionalAccessPolicyResource) basic(data acceptance.TestData) string {
+func (r ConditionalAccessPolicyResource) basic(data acceptance.TestData) string {
+	return r.withState(data, "disabled")
+}
+
+func (ConditionalAccessPolicyResource) withState(data acceptance.TestData, state string) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
 resource "azuread_conditional_access_policy" "test" {
   display_name = "acctest-CONPOLICY-%[1]d"
-  state        = "disabled"
+  state        = "%[2]s"
 
   conditions {
     client_app_types = ["browser"]
@@ -375,7 +415,7 @@ resource "azuread_conditional_access_policy" "test" {
     built_in_controls = ["block"]
   }
 }
-`, data.RandomInteger)
+`, data.RandomInteger, state)
 }
 
 func (ConditionalAccessPolicyResource) complete(data acceptance.TestData) string {
@@ -229,8 +229,8 @@ func flattenConditionalAccessDeviceFilter(in *stable.ConditionalAccessFilter) []
 
 	return []interface{}{
 		map[string]interface{}{
-			"mode": in.Mode,
-			"rule": in.Rule,
+			"mode": string(pointer.From(in.Mode)),
+			"rule": pointer.From(in.Rule),
 		},
 	}
 }
@@ -281,6 +281,7 @@ func flattenCountryNamedLocation(in *stable.CountryNamedLocation) []interface{}
 	return []interface{}{
 		map[string]interface{}{
 			"countries_and_regions":                 tf.FlattenStringSlice(in.CountriesAndRegions),
+			"country_lookup_method":                 string(pointer.From(in.CountryLookupMethod)),
 			"include_unknown_countries_and_regions": includeUnknown,
 		},
 	}
@@ -659,6 +660,10 @@ func expandCountryNamedLocation(in []interface{}) *stable.CountryNamedLocation {
 	result.CountriesAndRegions = tf.ExpandStringSlice(countriesAndRegions)
 	result.IncludeUnknownCountriesAndRegions = pointer.To(includeUnknown.(bool))
 
+	if lookupMethod := config["country_lookup_method"].(string); lookupMethod != "" {
+		result.CountryLookupMethod = pointer.To(stable.CountryLookupMethodType(lookupMethod))
+	}
+
 	return &result
 }
 
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conditionalaccess
+
+import (
+	"testing"
+)
+
+func TestValidateConditionalAccessSignInFrequency(t *testing.T) {
+	cases := []struct {
+		TestName  string
+		Frequency int
+		Period    string
+		Interval  string
+		Valid     bool
+	}{
+		{
+			TestName: "Unset",
+			Valid:    true,
+		},
+		{
+			TestName:  "Hours",
+			Frequency: 10,
+			Period:    "hours",
+			Interval:  "timeBased",
+			Valid:     true,
+		},
+		{
+			TestName:  "TooManyHours",
+			Frequency: 24,
+			Period:    "hours",
+			Valid:     false,
+		},
+		{
+			TestName:  "Days",
+			Frequency: 365,
+			Period:    "days",
+			Valid:     true,
+		},
+		{
+			TestName:  "TooManyDays",
+			Frequency: 366,
+			Period:    "days",
+			Valid:     false,
+		},
+		{
+			TestName: "EveryTime",
+			Interval: "everyTime",
+			Valid:    true,
+		},
+		{
+			TestName:  "EveryTimeWithFrequency",
+			Frequency: 1,
+			Period:    "hours",
+			Interval:  "everyTime",
+			Valid:     false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := validateConditionalAccessSignInFrequency(tc.Frequency, tc.Period, tc.Interval)
+			if tc.Valid && err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if !tc.Valid && err == nil {
+				t.Fatalf("expected an error but got none")
+			}
+		})
+	}
+}
@@ -106,6 +106,13 @@ func namedLocationResource() *pluginsdk.Resource {
 							},
 						},
 
+						"country_lookup_method": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      string(stable.CountryLookupMethodType_ClientIPAddress),
+							ValidateFunc: validation.StringInSlice(stable.PossibleValuesForCountryLookupMethodType(), false),
+						},
+
 						"include_unknown_countries_and_regions": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -266,6 +273,10 @@ func namedLocationResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData,
 				if location["include_unknown_countries_and_regions"].(bool) != ip["include_unknown_countries_and_regions"].(bool) {
 					return pointer.To(false), nil
 				}
+
+				if location["country_lookup_method"].(string) != ip["country_lookup_method"].(string) {
+					return pointer.To(false), nil
+				}
 			}
 
 			return pointer.To(true), nil
@@ -109,6 +109,30 @@ func TestAccNamedLocation_completeCountry(t *testing.T) {
 	})
 }
 
+func TestAccNamedLocation_countryLookupMethod(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
+	r := NamedLocationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.countryLookupMethod(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("country.0.country_lookup_method").HasValue("authenticatorAppGps"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicCountry(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("country.0.country_lookup_method").HasValue("clientIpAddress"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNamedLocation_updateCountry(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_named_location", "test")
 	r := NamedLocationResource{}
@@ -217,3 +241,18 @@ resource "azuread_named_location" "test" {
 }
 `, data.RandomInteger)
 }
+
+func (NamedLocationResource) countryLookupMethod(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_named_location" "test" {
+  display_name = "acctestNLC-%[1]d"
+  country {
+    countries_and_regions = [
+      "GB",
+      "US",
+    ]
+    country_lookup_method = "authenticatorAppGps"
+  }
+}
+`, data.RandomInteger)
+}
@@ -36,7 +36,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_named_location":            namedLocationResource(),
-		"azuread_conditional_access_policy": conditionalAccessPolicyResource(),
+		"azuread_authentication_context_class_reference": authenticationContextClassReferenceResource(),
+		"azuread_named_location":                         namedLocationResource(),
+		"azuread_conditional_access_policy":              conditionalAccessPolicyResource(),
 	}
 }
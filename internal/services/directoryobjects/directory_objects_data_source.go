@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryobjects
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryobjects/stable/directoryobject"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+// getByIdsMaxIds is the maximum number of IDs that can be specified in a single getByIds request
+const getByIdsMaxIds = 1000
+
+func directoryObjectsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: directoryObjectsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"object_ids": {
+				Description: "The object IDs of the directory objects to retrieve",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+			},
+
+			"types": {
+				Description: "A list of directory object types to which the results should be restricted",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"application",
+						"device",
+						"group",
+						"servicePrincipal",
+						"user",
+					}, false),
+				},
+			},
+
+			"ignore_missing": {
+				Description: "Ignore missing directory objects and return those that were found",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"objects": {
+				Description: "A list of directory objects",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"display_name": {
+							Description: "The display name of the directory object",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"object_id": {
+							Description: "The object ID of the directory object",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "The OData type of the directory object",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func directoryObjectsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).DirectoryObjects.DirectoryObjectClient
+
+	objectIds := tf.ExpandStringSlice(d.Get("object_ids").([]interface{}))
+	ignoreMissing := d.Get("ignore_missing").(bool)
+
+	var types *[]string
+	if v := d.Get("types").(*pluginsdk.Set).List(); len(v) > 0 {
+		types = tf.ExpandStringSlicePtr(v)
+	}
+
+	// Retrieve the objects in batches, since getByIds accepts a limited number of IDs per request
+	found := make(map[string]map[string]interface{})
+	for start := 0; start < len(objectIds); start += getByIdsMaxIds {
+		end := start + getByIdsMaxIds
+		if end > len(objectIds) {
+			end = len(objectIds)
+		}
+
+		input := directoryobject.ListGetsByIdsRequest{
+			Ids:   pointer.To(objectIds[start:end]),
+			Types: types,
+		}
+		resp, err := client.ListGetsByIdsComplete(ctx, input, directoryobject.DefaultListGetsByIdsOperationOptions())
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving directory objects")
+		}
+
+		for _, item := range resp.Items {
+			directoryObject := item.DirectoryObject()
+			if directoryObject.Id == nil {
+				return tf.ErrorDiagF(errors.New("API returned directory object with nil object ID"), "Bad API Response")
+			}
+
+			found[strings.ToLower(*directoryObject.Id)] = map[string]interface{}{
+				"display_name": directoryObjectDisplayName(item),
+				"object_id":    *directoryObject.Id,
+				"type":         formatODataType(pointer.From(directoryObject.ODataType)),
+			}
+		}
+	}
+
+	// Return objects in the order they were requested, since getByIds does not guarantee ordering
+	objects := make([]map[string]interface{}, 0)
+	missing := make([]string, 0)
+	for _, id := range objectIds {
+		if object, ok := found[strings.ToLower(id)]; ok {
+			objects = append(objects, object)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	if !ignoreMissing && len(missing) > 0 {
+		return tf.ErrorDiagPathF(fmt.Errorf("not found: %s", strings.Join(missing, ", ")), "object_ids", "One or more directory objects were not found")
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(objectIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for object IDs")
+	}
+
+	d.SetId("directoryObjects#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "objects", objects)
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directoryobjects_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type DirectoryObjectsDataSource struct{}
+
+func TestAccDirectoryObjectsDataSource_byObjectIds(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_objects", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: DirectoryObjectsDataSource{}.byObjectIds(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("objects.#").HasValue("3"),
+				check.That(data.ResourceName).Key("objects.0.object_id").MatchesOtherKey(check.That("azuread_group.test").Key("object_id")),
+				check.That(data.ResourceName).Key("objects.0.type").HasValue("Group"),
+				check.That(data.ResourceName).Key("objects.0.display_name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("objects.1.object_id").MatchesOtherKey(check.That("azuread_user.test").Key("object_id")),
+				check.That(data.ResourceName).Key("objects.1.type").HasValue("User"),
+				check.That(data.ResourceName).Key("objects.2.object_id").MatchesOtherKey(check.That("azuread_service_principal.test").Key("object_id")),
+				check.That(data.ResourceName).Key("objects.2.type").HasValue("ServicePrincipal"),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryObjectsDataSource_types(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_objects", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: DirectoryObjectsDataSource{}.types(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("objects.#").HasValue("1"),
+				check.That(data.ResourceName).Key("objects.0.type").HasValue("User"),
+			),
+		},
+	})
+}
+
+func TestAccDirectoryObjectsDataSource_missing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_directory_objects", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      DirectoryObjectsDataSource{}.missing(data, false),
+			ExpectError: regexp.MustCompile("One or more directory objects were not found"),
+		},
+		{
+			Config: DirectoryObjectsDataSource{}.missing(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("objects.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (DirectoryObjectsDataSource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+%[2]s
+%[3]s
+`, PrincipalTypeDataSource{}.basicGroup(data), PrincipalTypeDataSource{}.basicUser(data), PrincipalTypeDataSource{}.basicServicePrincipal(data))
+}
+
+func (r DirectoryObjectsDataSource) byObjectIds(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_objects" "test" {
+  object_ids = [
+    azuread_group.test.object_id,
+    azuread_user.test.object_id,
+    azuread_service_principal.test.object_id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r DirectoryObjectsDataSource) types(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_objects" "test" {
+  object_ids = [
+    azuread_group.test.object_id,
+    azuread_user.test.object_id,
+    azuread_service_principal.test.object_id,
+  ]
+
+  types = ["user"]
+}
+`, r.template(data))
+}
+
+func (DirectoryObjectsDataSource) missing(data acceptance.TestData, ignoreMissing bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_directory_objects" "test" {
+  object_ids = [
+    azuread_group.test.object_id,
+    "00000000-0000-0000-0000-000000000000",
+  ]
+
+  ignore_missing = %[2]t
+}
+`, PrincipalTypeDataSource{}.basicGroup(data), ignoreMissing)
+}
@@ -6,6 +6,7 @@ package directoryobjects
 import (
 	"strings"
 
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
@@ -13,3 +14,20 @@ import (
 func formatODataType(in string) string {
 	return cases.Title(language.AmericanEnglish, cases.NoLower).String(strings.TrimPrefix(in, "#microsoft.graph."))
 }
+
+// directoryObjectDisplayName returns the display name for supported directory object types
+func directoryObjectDisplayName(in stable.DirectoryObject) string {
+	switch v := in.(type) {
+	case stable.Application:
+		return v.DisplayName.GetOrZero()
+	case stable.Device:
+		return v.DisplayName.GetOrZero()
+	case stable.Group:
+		return v.DisplayName.GetOrZero()
+	case stable.ServicePrincipal:
+		return v.DisplayName.GetOrZero()
+	case stable.User:
+		return v.DisplayName.GetOrZero()
+	}
+	return ""
+}
@@ -27,7 +27,8 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_directory_object": directoryObjectDataSource(),
+		"azuread_directory_object":  directoryObjectDataSource(),
+		"azuread_directory_objects": directoryObjectsDataSource(),
 	}
 }
 
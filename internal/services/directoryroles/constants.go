@@ -4,3 +4,15 @@
 package directoryroles
 
 const directoryRoleMemberResourceName = "azuread_directory_role_member"
+
+const (
+	roleEligibilityScheduleRequestStatusCanceled                = "Canceled"
+	roleEligibilityScheduleRequestStatusDenied                  = "Denied"
+	roleEligibilityScheduleRequestStatusFailed                  = "Failed"
+	roleEligibilityScheduleRequestStatusGranted                 = "Granted"
+	roleEligibilityScheduleRequestStatusPendingAdminDecision    = "PendingAdminDecision"
+	roleEligibilityScheduleRequestStatusPendingApproval         = "PendingApproval"
+	roleEligibilityScheduleRequestStatusPendingProvisioning     = "PendingProvisioning"
+	roleEligibilityScheduleRequestStatusPendingScheduleCreation = "PendingScheduleCreation"
+	roleEligibilityScheduleRequestStatusRevoked                 = "Revoked"
+)
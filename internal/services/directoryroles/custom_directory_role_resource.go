@@ -81,7 +81,7 @@ func customDirectoryRoleResource() *pluginsdk.Resource {
 							Required:    true,
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
+								ValidateFunc: validation.StringIsRoleResourceAction,
 							},
 						},
 					},
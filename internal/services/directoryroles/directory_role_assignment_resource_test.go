@@ -6,6 +6,7 @@ package directoryroles_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -71,6 +72,24 @@ func TestAccDirectoryRoleAssignment_servicePrincipalScopedApplication(t *testing
 	})
 }
 
+func TestAccDirectoryRoleAssignment_servicePrincipalScopedAdministrativeUnit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment", "test")
+	r := DirectoryRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.servicePrincipalScopedAdministrativeUnit(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role_id").IsUuid(),
+				check.That(data.ResourceName).Key("principal_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("directory_scope_id").MatchesRegex(regexp.MustCompile("^/administrativeUnits/")),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDirectoryRoleAssignment_user(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_directory_role_assignment", "testA")
 	r := DirectoryRoleAssignmentResource{}
@@ -235,6 +254,32 @@ resource "azuread_directory_role_assignment" "test" {
 `, data.RandomInteger)
 }
 
+func (r DirectoryRoleAssignmentResource) servicePrincipalScopedAdministrativeUnit(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_directory_role" "test" {
+  display_name = "Groups administrator"
+}
+
+resource "azuread_administrative_unit" "test" {
+  display_name = "acctestAdministrativeUnit-%[1]d"
+}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+
+resource "azuread_directory_role_assignment" "test" {
+  role_id             = azuread_directory_role.test.template_id
+  principal_object_id = azuread_service_principal.test.object_id
+  directory_scope_id  = format("/administrativeUnits/%%s", azuread_administrative_unit.test.object_id)
+}
+`, data.RandomInteger)
+}
+
 func (r DirectoryRoleAssignmentResource) servicePrincipalCustomRole(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
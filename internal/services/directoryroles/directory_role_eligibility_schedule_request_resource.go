@@ -76,6 +76,61 @@ func directoryRoleEligibilityScheduleRequestResource() *pluginsdk.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			"start_date": {
+				Description:  "The date from which the role eligibility is effective, formatted as an RFC3339 date string (e.g. 2018-01-01T01:02:03Z)",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
+					// The API may return the date in a different, but equivalent, format to that which was configured
+					oldTime, err := time.Parse(time.RFC3339, old)
+					if err != nil {
+						return false
+					}
+					newTime, err := time.Parse(time.RFC3339, new)
+					if err != nil {
+						return false
+					}
+					return oldTime.Equal(newTime)
+				},
+			},
+
+			"expiration_date": {
+				Description:   "The date on which the role eligibility expires, formatted as an RFC3339 date string (e.g. 2018-01-01T01:02:03Z)",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"duration", "permanent_assignment"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"duration": {
+				Description:   "The duration of the role eligibility, formatted as an ISO8601 duration string (e.g. P3D for 3 days)",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"expiration_date", "permanent_assignment"},
+				ValidateFunc:  validation.ISO8601Duration,
+			},
+
+			"permanent_assignment": {
+				Description:   "Whether the role eligibility is permanent. Defaults to true when neither `expiration_date` nor `duration` are specified",
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"expiration_date", "duration"},
+			},
+
+			"status": {
+				Description: "The status of the role eligibility schedule request",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -88,18 +143,18 @@ func directoryRoleEligibilityScheduleRequestResourceCreate(ctx context.Context,
 	justification := d.Get("justification").(string)
 	directoryScopeId := d.Get("directory_scope_id").(string)
 
+	schedule, err := expandDirectoryRoleEligibilitySchedule(d)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Building schedule for role eligibility schedule request")
+	}
+
 	properties := stable.UnifiedRoleEligibilityScheduleRequest{
 		Action:           pointer.To(stable.UnifiedRoleScheduleRequestActions_AdminAssign),
 		RoleDefinitionId: nullable.Value(roleDefinitionId),
 		PrincipalId:      nullable.Value(principalId),
 		Justification:    nullable.Value(justification),
 		DirectoryScopeId: nullable.Value(directoryScopeId),
-		ScheduleInfo: &stable.RequestSchedule{
-			StartDateTime: nullable.Value(time.Now().Format(time.RFC3339)),
-			Expiration: &stable.ExpirationPattern{
-				Type: pointer.To(stable.ExpirationPatternType_NoExpiration),
-			},
-		},
+		ScheduleInfo:     schedule,
 	}
 
 	options := directoryroleeligibilityschedulerequest.CreateDirectoryRoleEligibilityScheduleRequestOperationOptions{
@@ -120,6 +175,9 @@ func directoryRoleEligibilityScheduleRequestResourceCreate(ctx context.Context,
 	if roleEligibilityScheduleRequest == nil || roleEligibilityScheduleRequest.Id == nil {
 		return tf.ErrorDiagF(errors.New("returned role roleEligibilityScheduleRequest ID was nil"), "API Error")
 	}
+	if pointer.From(roleEligibilityScheduleRequest.Status) == roleEligibilityScheduleRequestStatusFailed {
+		return tf.ErrorDiagF(errors.New("request is in a failed state"), "Creating eligibility schedule request for role %q to principal %q", roleDefinitionId, principalId)
+	}
 
 	id := stable.NewRoleManagementDirectoryRoleEligibilityScheduleRequestID(*roleEligibilityScheduleRequest.Id)
 	d.SetId(id.UnifiedRoleEligibilityScheduleRequestId)
@@ -159,10 +217,42 @@ func directoryRoleEligibilityScheduleRequestResourceRead(ctx context.Context, d
 		return tf.ErrorDiagF(errors.New("model was nil"), "API Error")
 	}
 
+	status := pointer.From(roleEligibilityScheduleRequest.Status)
+	switch status {
+	case roleEligibilityScheduleRequestStatusCanceled,
+		roleEligibilityScheduleRequestStatusDenied,
+		roleEligibilityScheduleRequestStatusFailed,
+		roleEligibilityScheduleRequestStatusRevoked:
+		log.Printf("[DEBUG] %s has status %q and no longer grants role eligibility - removing from state", id, status)
+		d.SetId("")
+		return nil
+
+	case roleEligibilityScheduleRequestStatusPendingAdminDecision,
+		roleEligibilityScheduleRequestStatusPendingApproval:
+		// The request remains in state whilst awaiting approval, so that it is not submitted again
+		log.Printf("[DEBUG] %s is awaiting approval (status %q)", id, status)
+	}
+
 	tf.Set(d, "role_definition_id", roleEligibilityScheduleRequest.RoleDefinitionId.GetOrZero())
 	tf.Set(d, "principal_id", roleEligibilityScheduleRequest.PrincipalId.GetOrZero())
 	tf.Set(d, "justification", roleEligibilityScheduleRequest.Justification.GetOrZero())
 	tf.Set(d, "directory_scope_id", roleEligibilityScheduleRequest.DirectoryScopeId.GetOrZero())
+	tf.Set(d, "status", status)
+
+	startDate, expirationDate, duration, permanent := "", "", "", false
+	if scheduleInfo := roleEligibilityScheduleRequest.ScheduleInfo; scheduleInfo != nil {
+		startDate = scheduleInfo.StartDateTime.GetOrZero()
+		if expiration := scheduleInfo.Expiration; expiration != nil {
+			expirationDate = expiration.EndDateTime.GetOrZero()
+			duration = expiration.Duration.GetOrZero()
+			permanent = pointer.From(expiration.Type) == stable.ExpirationPatternType_NoExpiration
+		}
+	}
+
+	tf.Set(d, "start_date", startDate)
+	tf.Set(d, "expiration_date", expirationDate)
+	tf.Set(d, "duration", duration)
+	tf.Set(d, "permanent_assignment", permanent)
 
 	return nil
 }
@@ -173,6 +263,9 @@ func directoryRoleEligibilityScheduleRequestResourceDelete(ctx context.Context,
 
 	resp, err := client.GetDirectoryRoleEligibilityScheduleRequest(ctx, id, directoryroleeligibilityschedulerequest.DefaultGetDirectoryRoleEligibilityScheduleRequestOperationOptions())
 	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
 		return tf.ErrorDiagF(err, "Retrieving %s", id)
 	}
 
@@ -181,6 +274,28 @@ func directoryRoleEligibilityScheduleRequestResourceDelete(ctx context.Context,
 		return tf.ErrorDiagF(errors.New("model was nil"), "API Error")
 	}
 
+	switch pointer.From(roleEligibilityScheduleRequest.Status) {
+	case roleEligibilityScheduleRequestStatusCanceled,
+		roleEligibilityScheduleRequestStatusDenied,
+		roleEligibilityScheduleRequestStatusFailed,
+		roleEligibilityScheduleRequestStatusRevoked:
+		return nil
+
+	case roleEligibilityScheduleRequestStatusGranted,
+		roleEligibilityScheduleRequestStatusPendingAdminDecision,
+		roleEligibilityScheduleRequestStatusPendingApproval,
+		roleEligibilityScheduleRequestStatusPendingProvisioning,
+		roleEligibilityScheduleRequestStatusPendingScheduleCreation:
+		// The request has not yet been provisioned, so it must be canceled rather than removed
+		if resp, err := client.CancelDirectoryRoleEligibilityScheduleRequest(ctx, id, directoryroleeligibilityschedulerequest.DefaultCancelDirectoryRoleEligibilityScheduleRequestOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil
+			}
+			return tf.ErrorDiagF(err, "Canceling %s", id)
+		}
+		return nil
+	}
+
 	properties := stable.UnifiedRoleEligibilityScheduleRequest{
 		Action:           pointer.To(stable.UnifiedRoleScheduleRequestActions_AdminRemove),
 		RoleDefinitionId: roleEligibilityScheduleRequest.RoleDefinitionId,
@@ -195,3 +310,48 @@ func directoryRoleEligibilityScheduleRequestResourceDelete(ctx context.Context,
 
 	return nil
 }
+
+func expandDirectoryRoleEligibilitySchedule(d *pluginsdk.ResourceData) (*stable.RequestSchedule, error) {
+	startDate := d.Get("start_date").(string)
+	if startDate == "" {
+		startDate = time.Now().Format(time.RFC3339)
+	}
+
+	schedule := stable.RequestSchedule{
+		StartDateTime: nullable.Value(startDate),
+		Expiration:    &stable.ExpirationPattern{},
+	}
+
+	expirationDate := d.Get("expiration_date").(string)
+	duration := d.Get("duration").(string)
+
+	switch {
+	case expirationDate != "":
+		start, err := time.Parse(time.RFC3339, startDate)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `start_date`: %+v", err)
+		}
+		expiry, err := time.Parse(time.RFC3339, expirationDate)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `expiration_date`: %+v", err)
+		}
+		if !expiry.After(start) {
+			return nil, errors.New("`expiration_date` must be after `start_date`")
+		}
+
+		schedule.Expiration.EndDateTime = nullable.Value(expirationDate)
+		schedule.Expiration.Type = pointer.To(stable.ExpirationPatternType_AfterDateTime)
+
+	case duration != "":
+		schedule.Expiration.Duration = nullable.Value(duration)
+		schedule.Expiration.Type = pointer.To(stable.ExpirationPatternType_AfterDuration)
+
+	case !pluginsdk.IsExplicitlyNullInConfig(d, "permanent_assignment") && !d.Get("permanent_assignment").(bool):
+		return nil, errors.New("either `expiration_date` or `duration` must be set when `permanent_assignment` is false")
+
+	default:
+		schedule.Expiration.Type = pointer.To(stable.ExpirationPatternType_NoExpiration)
+	}
+
+	return &schedule, nil
+}
@@ -29,6 +29,26 @@ func TestAccRoleEligibilityScheduleRequest_builtin(t *testing.T) {
 			Config: r.builtin(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("permanent_assignment").HasValue("true"),
+				check.That(data.ResourceName).Key("start_date").Exists(),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccRoleEligibilityScheduleRequest_duration(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_role_eligibility_schedule_request", "test")
+	r := RoleEligibilityScheduleRequestResource{}
+
+	data.ResourceTestIgnoreDangling(t, r, []acceptance.TestStep{
+		{
+			Config: r.duration(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("duration").HasValue("P30D"),
+				check.That(data.ResourceName).Key("permanent_assignment").HasValue("false"),
+				check.That(data.ResourceName).Key("status").Exists(),
 			),
 		},
 	})
@@ -49,7 +69,7 @@ func (r RoleEligibilityScheduleRequestResource) Exists(ctx context.Context, clie
 	return pointer.To(true), nil
 }
 
-func (r RoleEligibilityScheduleRequestResource) builtin(data acceptance.TestData) string {
+func (RoleEligibilityScheduleRequestResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
 
@@ -66,6 +86,12 @@ resource "azuread_user" "test" {
 resource "azuread_directory_role" "test" {
   display_name = "Application Administrator"
 }
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r RoleEligibilityScheduleRequestResource) builtin(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
 
 resource "azuread_directory_role_eligibility_schedule_request" "test" {
   role_definition_id = azuread_directory_role.test.template_id
@@ -73,5 +99,19 @@ resource "azuread_directory_role_eligibility_schedule_request" "test" {
   directory_scope_id = "/"
   justification      = "abc"
 }
-`, data.RandomInteger, data.RandomPassword)
+`, r.template(data))
+}
+
+func (r RoleEligibilityScheduleRequestResource) duration(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_directory_role_eligibility_schedule_request" "test" {
+  role_definition_id = azuread_directory_role.test.template_id
+  principal_id       = azuread_user.test.object_id
+  directory_scope_id = "/"
+  justification      = "abc"
+  duration           = "P30D"
+}
+`, r.template(data))
 }
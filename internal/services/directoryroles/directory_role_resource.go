@@ -15,7 +15,6 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryroles/stable/directoryrole"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryroletemplates/stable/directoryroletemplate"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
-	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
@@ -148,31 +147,31 @@ func (r DirectoryRoleResource) Create() sdk.ResourceFunc {
 			}
 
 			templateId := *template.Id
-			var directoryRole *stable.DirectoryRole
 
-			// Now look for the directory role created from that template
-			options := directoryrole.ListDirectoryRolesOperationOptions{
-				Filter: pointer.To(fmt.Sprintf("roleTemplateId eq '%s'", odata.EscapeSingleQuote(templateId))),
+			// Now look for the directory role created from that template, so that already-activated roles are adopted
+			directoryRole, err := directoryRoleFindByTemplateId(ctx, client, templateId)
+			if err != nil {
+				return fmt.Errorf("retrieving directory role with template ID %q: %v", templateId, err)
 			}
 
-			if resp, err := client.ListDirectoryRoles(ctx, options); err != nil {
-				if response.WasNotFound(resp.HttpResponse) {
-					// Directory role was not found, so activate it
-					properties := stable.DirectoryRole{
-						RoleTemplateId: nullable.Value(templateId),
-					}
-					if resp, err := client.CreateDirectoryRole(ctx, properties, directoryrole.DefaultCreateDirectoryRoleOperationOptions()); err != nil {
+			if directoryRole == nil {
+				// Directory role was not found, so activate it
+				properties := stable.DirectoryRole{
+					RoleTemplateId: nullable.Value(templateId),
+				}
+
+				resp, err := client.CreateDirectoryRole(ctx, properties, directoryrole.DefaultCreateDirectoryRoleOperationOptions())
+				if err != nil {
+					if !response.WasConflict(resp.HttpResponse) {
 						return fmt.Errorf("activating directory role for template ID %q: %v", templateId, err)
-					} else {
-						directoryRole = resp.Model
+					}
+
+					// The role was activated concurrently, so adopt it
+					if directoryRole, err = directoryRoleFindByTemplateId(ctx, client, templateId); err != nil {
+						return fmt.Errorf("retrieving directory role with template ID %q: %v", templateId, err)
 					}
 				} else {
-					return fmt.Errorf("retrieving directory role with template ID %q: %v", templateId, err)
-				}
-			} else if resp.Model != nil {
-				for _, role := range *resp.Model {
-					directoryRole = &role
-					break
+					directoryRole = resp.Model
 				}
 			}
 
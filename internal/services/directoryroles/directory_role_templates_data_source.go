@@ -36,6 +36,15 @@ func directoryRoleTemplatesDataSource() *pluginsdk.Resource {
 				},
 			},
 
+			"role_template_ids": {
+				Description: "Mapping of role template display names to object IDs",
+				Type:        pluginsdk.TypeMap,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"role_templates": {
 				Description: "A list of role templates",
 				Type:        pluginsdk.TypeList,
@@ -80,6 +89,7 @@ func directoryRoleTemplatesDataSourceRead(ctx context.Context, d *pluginsdk.Reso
 	}
 
 	objectIds := make([]string, 0)
+	templateIds := make(map[string]string)
 	templateList := make([]map[string]interface{}, 0)
 
 	for _, r := range *directoryRoleTemplates {
@@ -89,6 +99,7 @@ func directoryRoleTemplatesDataSourceRead(ctx context.Context, d *pluginsdk.Reso
 		}
 
 		objectIds = append(objectIds, *r.Id)
+		templateIds[r.DisplayName.GetOrZero()] = *r.Id
 
 		template := make(map[string]interface{})
 		template["description"] = r.Description.GetOrZero()
@@ -106,6 +117,7 @@ func directoryRoleTemplatesDataSourceRead(ctx context.Context, d *pluginsdk.Reso
 
 	d.SetId("templates#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
 
+	tf.Set(d, "role_template_ids", templateIds)
 	tf.Set(d, "role_templates", templateList)
 	tf.Set(d, "object_ids", objectIds)
 
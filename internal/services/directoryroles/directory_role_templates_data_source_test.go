@@ -30,6 +30,7 @@ func (DirectoryRoleTemplatesDataSource) testCheckFunc(data acceptance.TestData,
 		check.That(data.ResourceName).Key("role_templates.0.display_name").Exists(),
 		check.That(data.ResourceName).Key("role_templates.0.object_id").Exists(),
 		check.That(data.ResourceName).Key("object_ids.#").Exists(),
+		check.That(data.ResourceName).Key("role_template_ids.Global Administrator").HasValue("62e90394-69f5-4237-9190-012177145e10"),
 	}
 	checks = append(checks, additionalChecks...)
 	return acceptance.ComposeTestCheckFunc(checks...)
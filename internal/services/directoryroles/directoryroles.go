@@ -6,11 +6,14 @@ package directoryroles
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryroles/stable/directoryrole"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/directoryroles/stable/member"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 )
@@ -40,6 +43,32 @@ func directoryRoleGetMember(ctx context.Context, client *member.MemberClient, id
 	return nil, nil
 }
 
+// directoryRoleFindByTemplateId returns the activated directory role for the given template ID, or nil when the role
+// has not yet been activated in the tenant.
+func directoryRoleFindByTemplateId(ctx context.Context, client *directoryrole.DirectoryRoleClient, templateId string) (*stable.DirectoryRole, error) {
+	options := directoryrole.ListDirectoryRolesOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("roleTemplateId eq '%s'", odata.EscapeSingleQuote(templateId))),
+	}
+
+	resp, err := client.ListDirectoryRoles(ctx, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if resp.Model != nil {
+		for _, role := range *resp.Model {
+			if strings.EqualFold(role.RoleTemplateId.GetOrZero(), templateId) {
+				return &role, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
 func expandCustomRolePermissions(in []interface{}) []stable.UnifiedRolePermission {
 	result := make([]stable.UnifiedRolePermission, 0)
 	for _, permRaw := range in {
@@ -11,7 +11,10 @@ import (
 	memberofBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/memberof"
 	ownerBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/owner"
 	transitivememberBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/transitivemember"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/setting"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/grouplifecyclepolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/groupsetting"
 )
 
 // Note: Whilst it is technically possible that we could use both the Stable and Beta APIs for groups (retaining use of
@@ -21,10 +24,13 @@ import (
 type Client struct {
 	AdministrativeUnitMemberClientBeta *administrativeunitmemberBeta.AdministrativeUnitMemberClient
 	DirectoryObjectClient              *directoryobject.DirectoryObjectClient
+	DirectorySettingClient             *groupsetting.GroupSettingClient
 	GroupClientBeta                    *groupBeta.GroupClient
+	GroupLifecyclePolicyClient         *grouplifecyclepolicy.GroupLifecyclePolicyClient
 	GroupMemberClientBeta              *memberBeta.MemberClient
 	GroupMemberOfClientBeta            *memberofBeta.MemberOfClient
 	GroupOwnerClientBeta               *ownerBeta.OwnerClient
+	GroupSettingClient                 *setting.SettingClient
 	GroupTransitiveMemberClientBeta    *transitivememberBeta.TransitiveMemberClient
 }
 
@@ -41,6 +47,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(directoryObjectClient.Client)
 
+	directorySettingClient, err := groupsetting.NewGroupSettingClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(directorySettingClient.Client)
+
 	// resourceBehaviorOptions & resourceProvisioningOptions fields not supported in v1.0 API
 	groupClientBeta, err := groupBeta.NewGroupClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
@@ -48,6 +60,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(groupClientBeta.Client)
 
+	groupLifecyclePolicyClient, err := grouplifecyclepolicy.NewGroupLifecyclePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(groupLifecyclePolicyClient.Client)
+
 	// Group members not returned in full when using v1.0 API, see https://github.com/hashicorp/terraform-provider-azuread/issues/1018
 	memberClientBeta, err := memberBeta.NewMemberClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
@@ -67,6 +85,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(ownerClientBeta.Client)
 
+	settingClient, err := setting.NewSettingClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(settingClient.Client)
+
 	// Group members not returned in full when using v1.0 API, see https://github.com/hashicorp/terraform-provider-azuread/issues/1018
 	transitiveMemberClientBeta, err := transitivememberBeta.NewTransitiveMemberClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
@@ -77,10 +101,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	return &Client{
 		AdministrativeUnitMemberClientBeta: administrativeUnitMemberClientBeta,
 		DirectoryObjectClient:              directoryObjectClient,
+		DirectorySettingClient:             directorySettingClient,
 		GroupClientBeta:                    groupClientBeta,
+		GroupLifecyclePolicyClient:         groupLifecyclePolicyClient,
 		GroupMemberClientBeta:              memberClientBeta,
 		GroupMemberOfClientBeta:            memberOfClientBeta,
 		GroupOwnerClientBeta:               ownerClientBeta,
+		GroupSettingClient:                 settingClient,
 		GroupTransitiveMemberClientBeta:    transitiveMemberClientBeta,
 	}, nil
 }
@@ -80,3 +80,15 @@ const (
 )
 
 var possibleValuesForOnPremisesGroupType = []string{OnPremisesGroupTypeUniversalDistributionGroup, OnPremisesGroupTypeUniversalMailEnabledSecurityGroup, OnPremisesGroupTypeUniversalSecurityGroup}
+
+const (
+	GroupLifecyclePolicyManagedGroupTypesAll      = "All"
+	GroupLifecyclePolicyManagedGroupTypesNone     = "None"
+	GroupLifecyclePolicyManagedGroupTypesSelected = "Selected"
+)
+
+var possibleValuesForGroupLifecyclePolicyManagedGroupTypes = []string{
+	GroupLifecyclePolicyManagedGroupTypesAll,
+	GroupLifecyclePolicyManagedGroupTypesNone,
+	GroupLifecyclePolicyManagedGroupTypesSelected,
+}
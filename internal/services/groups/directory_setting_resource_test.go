@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/groupsetting"
+)
+
+type DirectorySettingResource struct{}
+
+// Only one setting can exist in a tenant for each template, so these tests are run in sequence
+func TestAccDirectorySetting(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"directorySetting": {
+			"basic":  testAccDirectorySetting_basic,
+			"update": testAccDirectorySetting_update,
+		},
+	})
+}
+
+func testAccDirectorySetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Group.Unified"),
+				check.That(data.ResourceName).Key("values.%").HasValue("1"),
+				check.That(data.ResourceName).Key("values.UsageGuidelinesUrl").HasValue("https://example.com/guidelines"),
+			),
+		},
+		data.ImportStep("values"),
+	})
+}
+
+func testAccDirectorySetting_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_directory_setting", "test")
+	r := DirectorySettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("values"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.%").HasValue("2"),
+				check.That(data.ResourceName).Key("values.AllowGuestsToAccessGroups").HasValue("false"),
+			),
+		},
+		data.ImportStep("values"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("values"),
+	})
+}
+
+func (r DirectorySettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.DirectorySettingClient
+
+	id, err := groupsetting.ParseGroupSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetGroupSetting(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (DirectorySettingResource) basic(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_directory_setting" "test" {
+  template_id = "62375ab9-6b52-47ed-826b-58e47e0e304b"
+
+  values = {
+    UsageGuidelinesUrl = "https://example.com/guidelines"
+  }
+}
+`
+}
+
+func (DirectorySettingResource) complete(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_directory_setting" "test" {
+  template_id = "62375ab9-6b52-47ed-826b-58e47e0e304b"
+
+  values = {
+    AllowGuestsToAccessGroups = "false"
+    UsageGuidelinesUrl        = "https://example.com/guidelines"
+  }
+}
+`
+}
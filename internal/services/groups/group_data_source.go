@@ -114,8 +114,9 @@ func groupDataSource() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"enabled": {
-							Type:     pluginsdk.TypeBool,
-							Computed: true,
+							Description: "Whether rule processing is `On` (true) or `Paused` (false)",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
 						},
 
 						"rule": {
@@ -160,6 +161,15 @@ func groupDataSource() *pluginsdk.Resource {
 				},
 			},
 
+			"transitive_members": {
+				Description: "The object IDs of all transitive group members, including members of nested groups. Only populated when `include_transitive_members` is `true`",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"onpremises_domain_name": {
 				Description: "The on-premises FQDN, also called dnsDomainName, synchronized from the on-premises directory when Azure AD Connect is used",
 				Type:        pluginsdk.TypeString,
@@ -459,13 +469,13 @@ func groupDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta in
 
 	includeTransitiveMembers := d.Get("include_transitive_members").(bool)
 	var members *[]string
+	transitiveMembers := make([]string, 0)
 	if includeTransitiveMembers {
 		resp, err := transitiveMemberClient.ListTransitiveMembers(ctx, beta.GroupId(id), transitivememberBeta.DefaultListTransitiveMembersOperationOptions())
 		if err != nil {
 			return tf.ErrorDiagF(err, "Could not retrieve transitive group members for group with object ID: %q", d.Id())
 		}
 		if resp.Model != nil {
-			transitiveMembers := make([]string, 0)
 			for _, object := range *resp.Model {
 				transitiveMembers = append(transitiveMembers, pointer.From(object.DirectoryObject().Id))
 			}
@@ -485,6 +495,7 @@ func groupDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta in
 		}
 	}
 	tf.Set(d, "members", members)
+	tf.Set(d, "transitive_members", transitiveMembers)
 
 	resp, err := ownerClient.ListOwners(ctx, beta.GroupId(id), ownerBeta.DefaultListOwnersOperationOptions())
 	if err != nil {
@@ -168,6 +168,7 @@ func TestAccGroupDataSource_transitiveMembers(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestGroup-%d", data.RandomInteger)),
 				check.That(data.ResourceName).Key("members.#").HasValue("4"),
+				check.That(data.ResourceName).Key("transitive_members.#").HasValue("4"),
 			),
 		},
 	})
@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/licenses"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+func groupLicenseAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupLicenseAssignmentResourceCreate,
+		ReadContext:   groupLicenseAssignmentResourceRead,
+		UpdateContext: groupLicenseAssignmentResourceUpdate,
+		DeleteContext: groupLicenseAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.GroupLicenseID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_object_id": {
+				Description:  "The object ID of the group to which the license should be assigned",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"sku_id": licenses.SkuIdSchema(),
+
+			"disabled_plans": licenses.DisabledPlansSchema(),
+		},
+	}
+}
+
+func groupLicenseAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+
+	resourceId := parse.NewGroupLicenseID(d.Get("group_object_id").(string), d.Get("sku_id").(string))
+	groupId := beta.NewGroupID(resourceId.GroupId)
+
+	tf.LockByName(groupResourceName, resourceId.GroupId)
+	defer tf.UnlockByName(groupResourceName, resourceId.GroupId)
+
+	if resp, err := client.GetGroup(ctx, groupId, groupBeta.DefaultGetGroupOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "%s was not found", groupId)
+		}
+		return tf.ErrorDiagPathF(err, "group_object_id", "Retrieving %s", groupId)
+	}
+
+	existing, err := groupGetAssignedLicense(ctx, client, groupId, resourceId.SkuId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing license %q for %s", resourceId.SkuId, groupId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag("azuread_group_license_assignment", resourceId.String())
+	}
+
+	disabledPlans := tf.ExpandStringSlice(d.Get("disabled_plans").(*pluginsdk.Set).List())
+
+	if err = groupAssignLicense(ctx, client, groupId, resourceId.SkuId, disabledPlans); err != nil {
+		return tf.ErrorDiagF(err, "Assigning license %q to %s", resourceId.SkuId, groupId)
+	}
+
+	d.SetId(resourceId.String())
+
+	return groupLicenseAssignmentResourceRead(ctx, d, meta)
+}
+
+func groupLicenseAssignmentResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+
+	resourceId, err := parse.GroupLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group License ID %q", d.Id())
+	}
+	groupId := beta.NewGroupID(resourceId.GroupId)
+
+	tf.LockByName(groupResourceName, resourceId.GroupId)
+	defer tf.UnlockByName(groupResourceName, resourceId.GroupId)
+
+	// Assigning a license which is already assigned replaces its disabled plans
+	disabledPlans := tf.ExpandStringSlice(d.Get("disabled_plans").(*pluginsdk.Set).List())
+
+	if err = groupAssignLicense(ctx, client, groupId, resourceId.SkuId, disabledPlans); err != nil {
+		return tf.ErrorDiagF(err, "Updating license %q for %s", resourceId.SkuId, groupId)
+	}
+
+	return groupLicenseAssignmentResourceRead(ctx, d, meta)
+}
+
+func groupLicenseAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+
+	resourceId, err := parse.GroupLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group License ID %q", d.Id())
+	}
+	groupId := beta.NewGroupID(resourceId.GroupId)
+
+	license, err := groupGetAssignedLicense(ctx, client, groupId, resourceId.SkuId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving license %q for %s", resourceId.SkuId, groupId)
+	}
+	if license == nil {
+		log.Printf("[DEBUG] License %q for %s was not found - removing from state", resourceId.SkuId, groupId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "group_object_id", resourceId.GroupId)
+	tf.Set(d, "sku_id", resourceId.SkuId)
+	tf.Set(d, "disabled_plans", tf.FlattenStringSlicePtr(license.DisabledPlans))
+
+	return nil
+}
+
+func groupLicenseAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupClientBeta
+
+	resourceId, err := parse.GroupLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group License ID %q", d.Id())
+	}
+	groupId := beta.NewGroupID(resourceId.GroupId)
+
+	tf.LockByName(groupResourceName, resourceId.GroupId)
+	defer tf.UnlockByName(groupResourceName, resourceId.GroupId)
+
+	request := groupBeta.AssignLicenseRequest{
+		AddLicenses:    &[]beta.AssignedLicense{},
+		RemoveLicenses: &[]string{resourceId.SkuId},
+	}
+
+	if resp, err := client.AssignLicense(ctx, groupId, request, groupBeta.DefaultAssignLicenseOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found", groupId)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing license %q from %s", resourceId.SkuId, groupId)
+	}
+
+	if err := licenses.WaitForRemoval(ctx, groupAssignedLicenseFunc(client, groupId, resourceId.SkuId)); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of license %q from %s", resourceId.SkuId, groupId)
+	}
+
+	return nil
+}
+
+// groupAssignLicense assigns the license with the specified SKU ID to the group, and waits for the license to be
+// reflected with the expected disabled plans. The caller is expected to hold the lock for the group.
+func groupAssignLicense(ctx context.Context, client *groupBeta.GroupClient, id beta.GroupId, skuId string, disabledPlans []string) error {
+	request := groupBeta.AssignLicenseRequest{
+		AddLicenses: &[]beta.AssignedLicense{
+			{
+				SkuId:         nullable.Value(skuId),
+				DisabledPlans: pointer.To(disabledPlans),
+			},
+		},
+		RemoveLicenses: &[]string{},
+	}
+
+	if _, err := client.AssignLicense(ctx, id, request, groupBeta.DefaultAssignLicenseOperationOptions()); err != nil {
+		return err
+	}
+
+	return licenses.WaitForAssignment(ctx, disabledPlans, groupAssignedLicenseFunc(client, id, skuId))
+}
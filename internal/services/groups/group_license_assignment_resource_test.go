@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/parse"
+)
+
+type GroupLicenseAssignmentResource struct{}
+
+func TestAccGroupLicenseAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_license_assignment", "test")
+	r := GroupLicenseAssignmentResource{}
+
+	skuId := os.Getenv("ARM_TEST_LICENSE_SKU_ID")
+	if skuId == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_ID must be set to the ID of a SKU subscribed in the test tenant")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, skuId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("sku_id").HasValue(skuId),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupLicenseAssignment_disabledPlans(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_license_assignment", "test")
+	r := GroupLicenseAssignmentResource{}
+
+	skuId := os.Getenv("ARM_TEST_LICENSE_SKU_ID")
+	planId := os.Getenv("ARM_TEST_LICENSE_SERVICE_PLAN_ID")
+	if skuId == "" || planId == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_ID and ARM_TEST_LICENSE_SERVICE_PLAN_ID must be set to the IDs of a SKU subscribed in the test tenant and one of its service plans")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, skuId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabledPlans(data, skuId, planId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, skuId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GroupLicenseAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupClientBeta
+
+	id, err := parse.GroupLicenseID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Group License ID: %v", err)
+	}
+
+	options := groupBeta.GetGroupOperationOptions{
+		Select: &[]string{"assignedLicenses"},
+	}
+
+	resp, err := client.GetGroup(ctx, beta.NewGroupID(id.GroupId), options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving group with object ID %q: %+v", id.GroupId, err)
+	}
+
+	if resp.Model != nil && resp.Model.AssignedLicenses != nil {
+		for _, license := range *resp.Model.AssignedLicenses {
+			if strings.EqualFold(license.SkuId.GetOrZero(), id.SkuId) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (GroupLicenseAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+`, data.RandomInteger)
+}
+
+func (r GroupLicenseAssignmentResource) basic(data acceptance.TestData, skuId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_license_assignment" "test" {
+  group_object_id = azuread_group.test.object_id
+  sku_id          = "%[2]s"
+}
+`, r.template(data), skuId)
+}
+
+func (r GroupLicenseAssignmentResource) disabledPlans(data acceptance.TestData, skuId, planId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_group_license_assignment" "test" {
+  group_object_id = azuread_group.test.object_id
+  sku_id          = "%[2]s"
+  disabled_plans  = ["%[3]s"]
+}
+`, r.template(data), skuId, planId)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/grouplifecyclepolicy"
+)
+
+func groupLifecyclePolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupLifecyclePolicyResourceCreate,
+		ReadContext:   groupLifecyclePolicyResourceRead,
+		UpdateContext: groupLifecyclePolicyResourceUpdate,
+		DeleteContext: groupLifecyclePolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := grouplifecyclepolicy.ParseGroupLifecyclePolicyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_lifetime_in_days": {
+				Description:  "The number of days before a group expires and needs to be renewed",
+				Type:         pluginsdk.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(30),
+			},
+
+			"managed_group_types": {
+				Description:  "The group types to which the expiration policy applies",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(possibleValuesForGroupLifecyclePolicyManagedGroupTypes, false),
+			},
+
+			"alternate_notification_emails": {
+				Description: "A list of email addresses to which notifications are sent for groups without owners",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsEmailAddress,
+				},
+			},
+		},
+	}
+}
+
+func groupLifecyclePolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	properties := stable.GroupLifecyclePolicy{
+		AlternateNotificationEmails: nullable.Value(strings.Join(tf.ExpandStringSlice(d.Get("alternate_notification_emails").([]interface{})), ";")),
+		GroupLifetimeInDays:         nullable.Value(int64(d.Get("group_lifetime_in_days").(int))),
+		ManagedGroupTypes:           nullable.Value(d.Get("managed_group_types").(string)),
+	}
+
+	resp, err := client.CreateGroupLifecyclePolicy(ctx, properties)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create group lifecycle policy")
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not create group lifecycle policy")
+	}
+	if policy.Id == nil || *policy.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned group lifecycle policy with nil object ID"), "Bad API Response")
+	}
+
+	id := grouplifecyclepolicy.NewGroupLifecyclePolicyID(*policy.Id)
+	d.SetId(id.ID())
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetGroupLifecyclePolicy(ctx, id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return groupLifecyclePolicyResourceRead(ctx, d, meta)
+}
+
+func groupLifecyclePolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	id, err := grouplifecyclepolicy.ParseGroupLifecyclePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Lifecycle Policy ID")
+	}
+
+	properties := stable.GroupLifecyclePolicy{
+		AlternateNotificationEmails: nullable.Value(strings.Join(tf.ExpandStringSlice(d.Get("alternate_notification_emails").([]interface{})), ";")),
+		GroupLifetimeInDays:         nullable.Value(int64(d.Get("group_lifetime_in_days").(int))),
+		ManagedGroupTypes:           nullable.Value(d.Get("managed_group_types").(string)),
+	}
+
+	if _, err = client.UpdateGroupLifecyclePolicy(ctx, *id, properties); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return groupLifecyclePolicyResourceRead(ctx, d, meta)
+}
+
+func groupLifecyclePolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	id, err := grouplifecyclepolicy.ParseGroupLifecyclePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Lifecycle Policy ID")
+	}
+
+	resp, err := client.GetGroupLifecyclePolicy(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	policy := resp.Model
+	if policy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "alternate_notification_emails", groupLifecyclePolicyFlattenEmails(policy.AlternateNotificationEmails.GetOrZero()))
+	tf.Set(d, "group_lifetime_in_days", int(policy.GroupLifetimeInDays.GetOrZero()))
+	tf.Set(d, "managed_group_types", policy.ManagedGroupTypes.GetOrZero())
+
+	return nil
+}
+
+func groupLifecyclePolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupLifecyclePolicyClient
+
+	id, err := grouplifecyclepolicy.ParseGroupLifecyclePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Lifecycle Policy ID")
+	}
+
+	if resp, err := client.DeleteGroupLifecyclePolicy(ctx, *id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetGroupLifecyclePolicy(ctx, *id); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+// groupLifecyclePolicyFlattenEmails splits the semicolon-separated list of notification email addresses returned by
+// the API
+func groupLifecyclePolicyFlattenEmails(in string) []string {
+	result := make([]string, 0)
+	for _, email := range strings.Split(in, ";") {
+		if email = strings.TrimSpace(email); email != "" {
+			result = append(result, email)
+		}
+	}
+	return result
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/groups/grouplifecyclepolicy"
+)
+
+type GroupLifecyclePolicyResource struct{}
+
+// Only one group lifecycle policy can exist in a tenant, so these tests are run in sequence
+func TestAccGroupLifecyclePolicy(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"groupLifecyclePolicy": {
+			"basic":    testAccGroupLifecyclePolicy_basic,
+			"complete": testAccGroupLifecyclePolicy_complete,
+			"update":   testAccGroupLifecyclePolicy_update,
+		},
+	})
+}
+
+func testAccGroupLifecyclePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_lifetime_in_days").HasValue("180"),
+				check.That(data.ResourceName).Key("managed_group_types").HasValue("All"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccGroupLifecyclePolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alternate_notification_emails.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccGroupLifecyclePolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_lifecycle_policy", "test")
+	r := GroupLifecyclePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("group_lifetime_in_days").HasValue("365"),
+				check.That(data.ResourceName).Key("alternate_notification_emails.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("alternate_notification_emails.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GroupLifecyclePolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupLifecyclePolicyClient
+
+	id, err := grouplifecyclepolicy.ParseGroupLifecyclePolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetGroupLifecyclePolicy(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (GroupLifecyclePolicyResource) basic(_ acceptance.TestData) string {
+	return `
+provider "azuread" {}
+
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 180
+  managed_group_types    = "All"
+}
+`
+}
+
+func (GroupLifecyclePolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_group_lifecycle_policy" "test" {
+  group_lifetime_in_days = 365
+  managed_group_types    = "All"
+
+  alternate_notification_emails = [
+    "acctest-admin-%[1]d@${data.azuread_domains.test.domains.0.domain_name}",
+    "acctest-security-%[1]d@${data.azuread_domains.test.domains.0.domain_name}",
+  ]
+}
+`, data.RandomInteger)
+}
@@ -130,15 +130,16 @@ func groupResource() *pluginsdk.Resource {
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"enabled": {
-							Type:     pluginsdk.TypeBool,
-							Required: true,
+							Description: "Whether rule processing is `On` (true) or `Paused` (false). Changing this pauses or resumes processing without recreating the group",
+							Type:        pluginsdk.TypeBool,
+							Required:    true,
 						},
 
 						"rule": {
 							Description:  "Rule to determine members for a dynamic group. Required when `group_types` contains 'DynamicMembership'",
 							Type:         pluginsdk.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringLenBetween(0, 3072),
+							ValidateFunc: validation.All(validation.StringLenBetween(0, 3072), validation.StringIsMembershipRule),
 						},
 					},
 				},
@@ -181,6 +182,14 @@ func groupResource() *pluginsdk.Resource {
 				ValidateDiagFunc: validation.MailNickname,
 			},
 
+			"mail_nickname_from_display_name": {
+				Description:   "If `true`, and `mail_nickname` is not specified, a unique mail alias will be generated from the display name of the group",
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"mail_nickname"},
+			},
+
 			"members": {
 				Description:   "A set of members who should be present in this group. Supported object types are Users, Groups or Service Principals",
 				Type:          pluginsdk.TypeSet,
@@ -376,7 +385,7 @@ func groupResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDif
 		return fmt.Errorf("`mail_enabled` must be true for unified groups")
 	}
 
-	if mailNickname := diff.Get("mail_nickname").(string); mailEnabled && mailNickname == "" {
+	if mailNickname := diff.Get("mail_nickname").(string); mailEnabled && mailNickname == "" && !diff.Get("mail_nickname_from_display_name").(bool) {
 		return fmt.Errorf("`mail_nickname` is required for mail-enabled groups")
 	}
 
@@ -420,8 +429,10 @@ func groupResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDif
 		}
 	}
 
-	if (visibilityOld.(string) == GroupVisibilityPrivate || visibilityOld.(string) == GroupVisibilityPublic) &&
-		visibilityNew.(string) == GroupVisibilityHiddenMembership {
+	// Hiddenmembership visibility can only be set when a group is created, and cannot subsequently be removed, so
+	// changing to or from this value requires the group to be replaced
+	if visibilityOld.(string) != "" && visibilityNew.(string) != "" && visibilityOld.(string) != visibilityNew.(string) &&
+		(visibilityOld.(string) == GroupVisibilityHiddenMembership || visibilityNew.(string) == GroupVisibilityHiddenMembership) {
 		diff.ForceNew("visibility")
 	}
 
@@ -467,6 +478,12 @@ func groupResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta in
 	mailNickname := groupDefaultMailNickname()
 	if v, ok := d.GetOk("mail_nickname"); ok && v.(string) != "" {
 		mailNickname = v.(string)
+	} else if d.Get("mail_nickname_from_display_name").(bool) {
+		generated, err := groupGenerateMailNickname(ctx, client, displayName)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "mail_nickname_from_display_name", "Could not generate a mail nickname for group with display name %q", displayName)
+		}
+		mailNickname = generated
 	}
 
 	behaviorOptions := make([]string, 0)
@@ -1349,6 +1366,7 @@ func groupResourceReadFunc(enableRetries bool) pluginsdk.ReadContextFunc {
 			preventDuplicates = v
 		}
 		tf.Set(d, "prevent_duplicate_names", preventDuplicates)
+		tf.Set(d, "mail_nickname_from_display_name", d.Get("mail_nickname_from_display_name").(bool))
 
 		return nil
 	}
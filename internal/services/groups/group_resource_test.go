@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
@@ -162,6 +164,38 @@ func TestAccGroup_dynamicMembership(t *testing.T) {
 	})
 }
 
+func TestAccGroup_dynamicMembershipPaused(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dynamicMembership(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dynamic_membership.0.enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dynamicMembershipPaused(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dynamic_membership.0.enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.dynamicMembership(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("dynamic_membership.0.enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccGroup_callerOwner(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -355,6 +389,23 @@ func TestAccGroup_preventDuplicateNamesPass(t *testing.T) {
 	})
 }
 
+func TestAccGroup_mailNicknameFromDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.mailNicknameFromDisplayName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("mail_nickname").HasValue(fmt.Sprintf("acctestGroupmailnickname%d", data.RandomInteger)),
+				check.That("azuread_group.duplicate").Key("mail_nickname").HasValue(fmt.Sprintf("acctestGroupmailnickname%d2", data.RandomInteger)),
+			),
+		},
+		data.ImportStep("mail_nickname_from_display_name"),
+	})
+}
+
 func TestAccGroup_preventDuplicateNamesFail(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -451,6 +502,47 @@ func TestAccGroup_visibility(t *testing.T) {
 	})
 }
 
+func TestAccGroup_visibilityHiddenMembership(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group", "test")
+	r := GroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.visibility(data, "Private"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.visibility(data, "Hiddenmembership"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(data.ResourceName, plancheck.ResourceActionReplace),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("visibility").HasValue("Hiddenmembership"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.visibility(data, "Public"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(data.ResourceName, plancheck.ResourceActionReplace),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("visibility").HasValue("Public"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccGroup_administrativeUnit(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_group", "test")
 	r := GroupResource{}
@@ -788,6 +880,24 @@ resource "azuread_group" "test" {
 `, data.RandomInteger)
 }
 
+func (GroupResource) dynamicMembershipPaused(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  description      = "Please delete me as this is a.test.AD group!"
+  types            = ["DynamicMembership", "Unified"]
+  mail_enabled     = true
+  mail_nickname    = "acctest.Group-%[1]d"
+  security_enabled = true
+
+  dynamic_membership {
+    enabled = false
+    rule    = "user.department -eq \"Sales\""
+  }
+}
+`, data.RandomInteger)
+}
+
 func (GroupResource) provisioning(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "test" {
@@ -801,6 +911,24 @@ resource "azuread_group" "test" {
 `, data.RandomInteger)
 }
 
+func (GroupResource) mailNicknameFromDisplayName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name                    = "acctestGroup (mail nickname) %[1]d"
+  mail_enabled                    = true
+  mail_nickname_from_display_name = true
+  types                           = ["Unified"]
+}
+
+resource "azuread_group" "duplicate" {
+  display_name                    = azuread_group.test.display_name
+  mail_enabled                    = true
+  mail_nickname_from_display_name = true
+  types                           = ["Unified"]
+}
+`, data.RandomInteger)
+}
+
 func (GroupResource) visibility(data acceptance.TestData, visibility string) string {
 	return fmt.Sprintf(`
 resource "azuread_group" "test" {
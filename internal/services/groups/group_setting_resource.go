@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/setting"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func groupSettingResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: groupSettingResourceCreate,
+		ReadContext:   groupSettingResourceRead,
+		UpdateContext: groupSettingResourceUpdate,
+		DeleteContext: groupSettingResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := stable.ParseGroupIdSettingID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"group_object_id": {
+				Description:  "The object ID of the group to which the setting applies",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"template_id": {
+				Description:  "The ID of the group setting template on which the setting is based",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"values": {
+				Description: "A map of setting names to values. Names must be defined by the setting template",
+				Type:        pluginsdk.TypeMap,
+				Required:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"display_name": {
+				Description: "The display name of the setting, which is inherited from the template",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func groupSettingResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+	templateClient := meta.(*clients.Client).Groups.DirectorySettingClient
+
+	groupId := stable.NewGroupID(d.Get("group_object_id").(string))
+	templateId := d.Get("template_id").(string)
+
+	tf.LockByName(groupResourceName, groupId.GroupId)
+	defer tf.UnlockByName(groupResourceName, groupId.GroupId)
+
+	templateResp, err := templateClient.GetGroupSettingTemplate(ctx, templateId)
+	if err != nil {
+		if response.WasNotFound(templateResp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "template_id", "Group setting template %q was not found", templateId)
+		}
+		return tf.ErrorDiagPathF(err, "template_id", "Retrieving group setting template %q", templateId)
+	}
+	if templateResp.Model == nil {
+		return tf.ErrorDiagPathF(errors.New("model was nil"), "template_id", "Retrieving group setting template %q", templateId)
+	}
+
+	// Only one setting can exist for each template
+	listResp, err := client.ListSettingsComplete(ctx, groupId, setting.DefaultListSettingsOperationOptions())
+	if err != nil {
+		if response.WasNotFound(listResp.LatestHttpResponse) {
+			return tf.ErrorDiagPathF(nil, "group_object_id", "%s was not found", groupId)
+		}
+		return tf.ErrorDiagF(err, "Checking for existing settings for %s", groupId)
+	}
+	for _, existing := range listResp.Items {
+		if strings.EqualFold(existing.TemplateId.GetOrZero(), templateId) && existing.Id != nil {
+			return tf.ImportAsExistsDiag("azuread_group_setting", stable.NewGroupIdSettingID(groupId.GroupId, *existing.Id).ID())
+		}
+	}
+
+	values, err := groupSettingExpandValues(*templateResp.Model, nil, nil, d.Get("values").(map[string]interface{}))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "values", "Invalid setting values")
+	}
+
+	properties := stable.GroupSetting{
+		TemplateId: nullable.Value(templateId),
+		Values:     values,
+	}
+
+	resp, err := client.CreateSetting(ctx, groupId, properties, setting.DefaultCreateSettingOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create setting for %s", groupId)
+	}
+
+	groupSetting := resp.Model
+	if groupSetting == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not create setting for %s", groupId)
+	}
+	if groupSetting.Id == nil || *groupSetting.Id == "" {
+		return tf.ErrorDiagF(errors.New("API returned group setting with nil ID"), "Bad API Response")
+	}
+
+	id := stable.NewGroupIdSettingID(groupId.GroupId, *groupSetting.Id)
+	d.SetId(id.ID())
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetSetting(ctx, id, setting.DefaultGetSettingOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return groupSettingResourceRead(ctx, d, meta)
+}
+
+func groupSettingResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+	templateClient := meta.(*clients.Client).Groups.DirectorySettingClient
+
+	id, err := stable.ParseGroupIdSettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Setting ID")
+	}
+
+	tf.LockByName(groupResourceName, id.GroupId)
+	defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+	templateId := d.Get("template_id").(string)
+	templateResp, err := templateClient.GetGroupSettingTemplate(ctx, templateId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "template_id", "Retrieving group setting template %q", templateId)
+	}
+	if templateResp.Model == nil {
+		return tf.ErrorDiagPathF(errors.New("model was nil"), "template_id", "Retrieving group setting template %q", templateId)
+	}
+
+	resp, err := client.GetSetting(ctx, *id, setting.DefaultGetSettingOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	oldValues, newValues := d.GetChange("values")
+	values, err := groupSettingExpandValues(*templateResp.Model, resp.Model.Values, oldValues.(map[string]interface{}), newValues.(map[string]interface{}))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "values", "Invalid setting values")
+	}
+
+	if _, err = client.UpdateSetting(ctx, *id, stable.GroupSetting{Values: values}, setting.DefaultUpdateSettingOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return groupSettingResourceRead(ctx, d, meta)
+}
+
+func groupSettingResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+
+	id, err := stable.ParseGroupIdSettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Setting ID")
+	}
+
+	resp, err := client.GetSetting(ctx, *id, setting.DefaultGetSettingOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	groupSetting := resp.Model
+	if groupSetting == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "display_name", groupSetting.DisplayName.GetOrZero())
+	tf.Set(d, "group_object_id", id.GroupId)
+	tf.Set(d, "template_id", groupSetting.TemplateId.GetOrZero())
+	tf.Set(d, "values", groupSettingFlattenValues(groupSetting.Values, d.Get("values").(map[string]interface{})))
+
+	return nil
+}
+
+func groupSettingResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Groups.GroupSettingClient
+
+	id, err := stable.ParseGroupIdSettingID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Group Setting ID")
+	}
+
+	tf.LockByName(groupResourceName, id.GroupId)
+	defer tf.UnlockByName(groupResourceName, id.GroupId)
+
+	if resp, err := client.DeleteSetting(ctx, *id, setting.DefaultDeleteSettingOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetSetting(ctx, *id, setting.DefaultGetSettingOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %v", id, err)
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/stable/setting"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type GroupSettingResource struct{}
+
+func TestAccGroupSetting_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_setting", "test")
+	r := GroupSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Group.Unified.Guest"),
+				check.That(data.ResourceName).Key("values.AllowToAddGuests").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccGroupSetting_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_group_setting", "test")
+	r := GroupSettingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.AllowToAddGuests").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("values.AllowToAddGuests").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r GroupSettingResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Groups.GroupSettingClient
+
+	id, err := stable.ParseGroupIdSettingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetSetting(ctx, *id, setting.DefaultGetSettingOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (GroupSettingResource) basic(data acceptance.TestData, allowGuests bool) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_group" "test" {
+  display_name     = "acctestGroup-%[1]d"
+  types            = ["Unified"]
+  mail_enabled     = true
+  mail_nickname    = "acctestGroup-%[1]d"
+  security_enabled = true
+}
+
+resource "azuread_group_setting" "test" {
+  group_object_id = azuread_group.test.object_id
+  template_id     = "08d542b9-071f-4e16-94b0-74abb372e3d9"
+
+  values = {
+    AllowToAddGuests = "%[2]t"
+  }
+}
+`, data.RandomInteger, allowGuests)
+}
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package grouplifecyclepolicy provides a client for the tenant-wide groupLifecyclePolicies API, which is not yet
+// available in the microsoft-graph SDK.
+package grouplifecyclepolicy
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type GroupLifecyclePolicyClient struct {
+	Client *msgraph.Client
+}
+
+func NewGroupLifecyclePolicyClientWithBaseURI(sdkApi sdkEnv.Api) (*GroupLifecyclePolicyClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "grouplifecyclepolicy", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating GroupLifecyclePolicyClient: %+v", err)
+	}
+
+	return &GroupLifecyclePolicyClient{
+		Client: client,
+	}, nil
+}
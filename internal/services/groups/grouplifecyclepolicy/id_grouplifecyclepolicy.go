@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package grouplifecyclepolicy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GroupLifecyclePolicyId is a struct representing the Resource ID for a Group Lifecycle Policy
+type GroupLifecyclePolicyId struct {
+	GroupLifecyclePolicyId string
+}
+
+// NewGroupLifecyclePolicyID returns a new GroupLifecyclePolicyId struct
+func NewGroupLifecyclePolicyID(groupLifecyclePolicyId string) GroupLifecyclePolicyId {
+	return GroupLifecyclePolicyId{
+		GroupLifecyclePolicyId: groupLifecyclePolicyId,
+	}
+}
+
+// ParseGroupLifecyclePolicyID parses 'input' into a GroupLifecyclePolicyId
+func ParseGroupLifecyclePolicyID(input string) (*GroupLifecyclePolicyId, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 2 || segments[0] != "groupLifecyclePolicies" || segments[1] == "" {
+		return nil, fmt.Errorf("parsing %q: expected an ID in the format /groupLifecyclePolicies/{groupLifecyclePolicyId}", input)
+	}
+
+	return &GroupLifecyclePolicyId{
+		GroupLifecyclePolicyId: segments[1],
+	}, nil
+}
+
+// ValidateGroupLifecyclePolicyID checks that 'input' can be parsed as a Group Lifecycle Policy ID
+func ValidateGroupLifecyclePolicyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseGroupLifecyclePolicyID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Group Lifecycle Policy ID
+func (id GroupLifecyclePolicyId) ID() string {
+	return fmt.Sprintf("/groupLifecyclePolicies/%s", id.GroupLifecyclePolicyId)
+}
+
+// String returns a human-readable description of this Group Lifecycle Policy ID
+func (id GroupLifecyclePolicyId) String() string {
+	return fmt.Sprintf("Group Lifecycle Policy (Group Lifecycle Policy: %q)", id.GroupLifecyclePolicyId)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package grouplifecyclepolicy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type OperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.GroupLifecyclePolicy
+}
+
+type operationOptions struct{}
+
+func (o operationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o operationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o operationOptions) ToQuery() *client.QueryParams {
+	return &client.QueryParams{}
+}
+
+// CreateGroupLifecyclePolicy - Create groupLifecyclePolicy. Creates a new groupLifecyclePolicy.
+func (c GroupLifecyclePolicyClient) CreateGroupLifecyclePolicy(ctx context.Context, input stable.GroupLifecyclePolicy) (result OperationResponse, err error) {
+	return c.execute(ctx, http.MethodPost, "/groupLifecyclePolicies", []int{http.StatusCreated, http.StatusOK}, &input, true)
+}
+
+// GetGroupLifecyclePolicy - Get groupLifecyclePolicy. Retrieve the properties and relationships of a groupLifecyclePolicy.
+func (c GroupLifecyclePolicyClient) GetGroupLifecyclePolicy(ctx context.Context, id GroupLifecyclePolicyId) (result OperationResponse, err error) {
+	return c.execute(ctx, http.MethodGet, id.ID(), []int{http.StatusOK}, nil, true)
+}
+
+// UpdateGroupLifecyclePolicy - Update groupLifecyclePolicy. Update the properties of a groupLifecyclePolicy.
+func (c GroupLifecyclePolicyClient) UpdateGroupLifecyclePolicy(ctx context.Context, id GroupLifecyclePolicyId, input stable.GroupLifecyclePolicy) (result OperationResponse, err error) {
+	return c.execute(ctx, http.MethodPatch, id.ID(), []int{http.StatusAccepted, http.StatusNoContent, http.StatusOK}, &input, false)
+}
+
+// DeleteGroupLifecyclePolicy - Delete groupLifecyclePolicy. Deletes a groupLifecyclePolicy.
+func (c GroupLifecyclePolicyClient) DeleteGroupLifecyclePolicy(ctx context.Context, id GroupLifecyclePolicyId) (result OperationResponse, err error) {
+	return c.execute(ctx, http.MethodDelete, id.ID(), []int{http.StatusNoContent, http.StatusOK}, nil, false)
+}
+
+func (c GroupLifecyclePolicyClient) execute(ctx context.Context, method, path string, expectedStatusCodes []int, input *stable.GroupLifecyclePolicy, unmarshal bool) (result OperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		OptionsObject:       operationOptions{},
+		Path:                path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if input != nil {
+		if err = req.Marshal(*input); err != nil {
+			return
+		}
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if unmarshal {
+		var model stable.GroupLifecyclePolicy
+		result.Model = &model
+		if err = resp.Unmarshal(result.Model); err != nil {
+			return
+		}
+	}
+
+	return
+}
@@ -7,14 +7,23 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	groupBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/group"
 	memberBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/member"
+	ownerBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/groups/beta/owner"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/licenses"
 )
 
+// groupMailNicknameMaxLength is the maximum length of a mail nickname accepted by the API
+const groupMailNicknameMaxLength = 64
+
 func groupDefaultMailNickname() string {
 	charSet := "0123456789abcdef"
 	result := make([]byte, 9)
@@ -25,6 +34,66 @@ func groupDefaultMailNickname() string {
 	return resultString[:8] + "-" + resultString[8:]
 }
 
+// groupSanitizeMailNickname derives a mail nickname from a display name, retaining only those characters that are
+// permitted in an email local part and not reserved by the API, and removing any leading, trailing or repeated periods
+func groupSanitizeMailNickname(displayName string) string {
+	var b strings.Builder
+	for _, r := range displayName {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case strings.ContainsRune("!#$%&'*+-/=?^_`{|}~.", r):
+			if r == '.' && strings.HasSuffix(b.String(), ".") {
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+
+	result := strings.Trim(b.String(), ".")
+	if len(result) > groupMailNicknameMaxLength {
+		result = strings.TrimRight(result[:groupMailNicknameMaxLength], ".")
+	}
+
+	return result
+}
+
+// groupGenerateMailNickname returns a mail nickname derived from the display name that is not already in use by
+// another group, appending a numeric suffix where necessary. When the display name contains no usable characters, a
+// random mail nickname is returned instead.
+func groupGenerateMailNickname(ctx context.Context, client *groupBeta.GroupClient, displayName string) (string, error) {
+	base := groupSanitizeMailNickname(displayName)
+	if base == "" {
+		return groupDefaultMailNickname(), nil
+	}
+
+	for i := 1; i <= 100; i++ {
+		candidate := base
+		if i > 1 {
+			suffix := fmt.Sprintf("%d", i)
+			if len(candidate)+len(suffix) > groupMailNicknameMaxLength {
+				candidate = strings.TrimRight(candidate[:groupMailNicknameMaxLength-len(suffix)], ".")
+			}
+			candidate += suffix
+		}
+
+		options := groupBeta.ListGroupsOperationOptions{
+			Filter: pointer.To(fmt.Sprintf("mailNickname eq '%s'", odata.EscapeSingleQuote(candidate))),
+		}
+
+		resp, err := client.ListGroups(ctx, options)
+		if err != nil {
+			return "", fmt.Errorf("unable to list Groups with filter %q: %v", *options.Filter, err)
+		}
+
+		if resp.Model == nil || len(*resp.Model) == 0 {
+			return candidate, nil
+		}
+	}
+
+	return "", fmt.Errorf("could not find an available mail nickname based on %q", base)
+}
+
 func groupFindByName(ctx context.Context, client *groupBeta.GroupClient, displayName string) (*[]beta.Group, error) {
 	options := groupBeta.ListGroupsOperationOptions{
 		Filter: pointer.To(fmt.Sprintf("displayName eq '%s'", displayName)),
@@ -96,3 +165,150 @@ func groupGetMember(ctx context.Context, client *memberBeta.MemberClient, id bet
 
 	return nil, nil
 }
+
+// groupGetOwner returns the owner of the group with the specified object ID, or nil if the group does not exist or the
+// object is not an owner.
+func groupGetOwner(ctx context.Context, client *ownerBeta.OwnerClient, id beta.GroupIdOwnerId) (*beta.DirectoryObject, error) {
+	options := ownerBeta.ListOwnersOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("id eq '%s'", id.DirectoryObjectId)),
+	}
+
+	resp, err := client.ListOwners(ctx, beta.NewGroupID(id.GroupId), options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		} else {
+			return nil, err
+		}
+	}
+
+	if resp.Model != nil {
+		for _, owner := range *resp.Model {
+			if owner.DirectoryObject().Id != nil && strings.EqualFold(*owner.DirectoryObject().Id, id.DirectoryObjectId) {
+				return &owner, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// groupGetAssignedLicense returns the license with the specified SKU ID which is assigned to the group, or nil if the
+// group does not exist or the license is not assigned.
+func groupGetAssignedLicense(ctx context.Context, client *groupBeta.GroupClient, id beta.GroupId, skuId string) (*beta.AssignedLicense, error) {
+	options := groupBeta.GetGroupOperationOptions{
+		Select: &[]string{"assignedLicenses"},
+	}
+
+	resp, err := client.GetGroup(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if resp.Model != nil && resp.Model.AssignedLicenses != nil {
+		for _, license := range *resp.Model.AssignedLicenses {
+			if strings.EqualFold(license.SkuId.GetOrZero(), skuId) {
+				return &license, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// groupAssignedLicenseFunc returns a function which retrieves the license with the specified SKU ID assigned to the group
+func groupAssignedLicenseFunc(client *groupBeta.GroupClient, id beta.GroupId, skuId string) licenses.AssignedLicenseFunc {
+	return func(ctx context.Context) (bool, *[]string, error) {
+		license, err := groupGetAssignedLicense(ctx, client, id, skuId)
+		if err != nil || license == nil {
+			return false, nil, err
+		}
+		return true, license.DisabledPlans, nil
+	}
+}
+
+// groupSettingExpandValues returns the complete set of values for a setting based on the provided template. Values in
+// `desired` take precedence, followed by any existing values which were not previously managed. Values which were
+// previously managed but have since been removed from the configuration are reset to their template default. An error
+// is returned if `desired` contains a name that is not defined by the template.
+func groupSettingExpandValues(template stable.GroupSettingTemplate, existing *[]stable.SettingValue, previous, desired map[string]interface{}) (*[]stable.SettingValue, error) {
+	templateValues := pointer.From(template.Values)
+
+	for name := range desired {
+		found := false
+		for _, v := range templateValues {
+			if strings.EqualFold(v.Name.GetOrZero(), name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			validNames := make([]string, 0, len(templateValues))
+			for _, v := range templateValues {
+				validNames = append(validNames, v.Name.GetOrZero())
+			}
+			return nil, fmt.Errorf("setting %q is not defined by template %q, valid settings are: %s", name, template.DisplayName.GetOrZero(), strings.Join(validNames, ", "))
+		}
+	}
+
+	result := make([]stable.SettingValue, 0, len(templateValues))
+	for _, v := range templateValues {
+		name := v.Name.GetOrZero()
+		value := v.DefaultValue.GetOrZero()
+
+		if configured, ok := groupSettingFindValue(desired, name); ok {
+			value = configured
+		} else if _, ok := groupSettingFindValue(previous, name); !ok && existing != nil {
+			for _, e := range *existing {
+				if strings.EqualFold(e.Name.GetOrZero(), name) {
+					value = e.Value.GetOrZero()
+					break
+				}
+			}
+		}
+
+		result = append(result, stable.SettingValue{
+			Name:  nullable.Value(name),
+			Value: nullable.Value(value),
+		})
+	}
+
+	return &result, nil
+}
+
+// groupSettingFlattenValues returns the setting values whose names are present in `known`, or all setting values when
+// `known` is empty, e.g. when importing.
+func groupSettingFlattenValues(values *[]stable.SettingValue, known map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if values == nil {
+		return result
+	}
+
+	for _, v := range *values {
+		name := v.Name.GetOrZero()
+		if len(known) == 0 {
+			result[name] = v.Value.GetOrZero()
+			continue
+		}
+		for k := range known {
+			if strings.EqualFold(k, name) {
+				result[k] = v.Value.GetOrZero()
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func groupSettingFindValue(values map[string]interface{}, name string) (string, bool) {
+	for k, v := range values {
+		if strings.EqualFold(k, name) {
+			return v.(string), true
+		}
+	}
+	return "", false
+}
@@ -81,6 +81,14 @@ func groupsDataSource() *pluginsdk.Resource {
 				ExactlyOneOf:  []string{"display_names", "display_name_prefix", "object_ids", "return_all"},
 			},
 
+			"assignable_to_role": {
+				Description:   "Whether the groups can be assigned to an Azure AD role",
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"object_ids"},
+			},
+
 			"mail_enabled": {
 				Description:   "Whether the groups are mail-enabled",
 				Type:          pluginsdk.TypeBool,
@@ -116,6 +124,9 @@ func groupsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta i
 
 	var filter []string
 
+	if v, ok := d.GetOkExists("assignable_to_role"); ok { //nolint:staticcheck // needed to detect unset booleans
+		filter = append(filter, fmt.Sprintf("isAssignableToRole eq %t", v.(bool)))
+	}
 	if v, ok := d.GetOkExists("mail_enabled"); ok { //nolint:staticcheck // needed to detect unset booleans
 		filter = append(filter, fmt.Sprintf("mailEnabled eq %t", v.(bool)))
 	}
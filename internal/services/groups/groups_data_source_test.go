@@ -200,6 +200,51 @@ func TestAccGroupsDataSource_returnAllSecurityNotMailEnabled(t *testing.T) {
 	})
 }
 
+func TestAccGroupsDataSource_returnAllAssignableToRole(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_groups", "test")
+	r := GroupsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.roleAssignableGroup(data),
+		},
+		{
+			Config: r.returnAllAssignableToRole(data),
+			Check: acceptance.ComposeAggregateTestCheckFunc(
+				check.That(data.ResourceName).Key("display_names.#").Exists(),
+				check.That(data.ResourceName).Key("object_ids.#").Exists(),
+				check.That(data.ResourceName).Key("object_ids").ValidatesWith(testCheckHasOnlyRoleAssignableGroups()),
+			),
+		},
+	})
+}
+
+func testCheckHasOnlyRoleAssignableGroups() check.KeyValidationFunc {
+	return func(ctx context.Context, clients *clients.Client, values []interface{}) error {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, 5*time.Minute)
+		defer cancel()
+		client := clients.Groups.GroupClientBeta
+
+		for _, v := range values {
+			id := beta.NewGroupID(v.(string))
+			resp, err := client.GetGroup(ctx, id, groupBeta.DefaultGetGroupOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+			group := resp.Model
+			if group == nil {
+				return fmt.Errorf("retrieving %s: group was nil", id)
+			}
+			if !group.IsAssignableToRole.GetOrZero() {
+				return fmt.Errorf("expected only role-assignable groups, encountered %s which is not assignable to roles", id)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testCheckHasOnlyMailEnabledGroups() check.KeyValidationFunc {
 	return testCheckGroupsDataSource(true, false, false, false)
 }
@@ -389,3 +434,26 @@ data "azuread_groups" "test" {
 }
 `, r.template(data))
 }
+
+func (GroupsDataSource) roleAssignableGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_group" "test" {
+  display_name       = "acctestGroup-assignable-%[1]d"
+  assignable_to_role = true
+  security_enabled   = true
+}
+`, data.RandomInteger)
+}
+
+func (r GroupsDataSource) returnAllAssignableToRole(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_groups" "test" {
+  assignable_to_role = true
+  return_all         = true
+
+  depends_on = [azuread_group.test]
+}
+`, r.roleAssignableGroup(data))
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groups
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGroupSanitizeMailNickname(t *testing.T) {
+	cases := []struct {
+		DisplayName string
+		Expected    string
+	}{
+		{
+			DisplayName: "Engineering",
+			Expected:    "Engineering",
+		},
+		{
+			DisplayName: "Sales & Marketing (EMEA)",
+			Expected:    "Sales&MarketingEMEA",
+		},
+		{
+			DisplayName: "team@example.com; \"ops\"",
+			Expected:    "teamexample.comops",
+		},
+		{
+			DisplayName: "..Leading...and.trailing..",
+			Expected:    "Leading.and.trailing",
+		},
+		{
+			DisplayName: "Équipe Développement",
+			Expected:    "quipeDveloppement",
+		},
+		{
+			DisplayName: "()[]<>",
+			Expected:    "",
+		},
+		{
+			DisplayName: strings.Repeat("a", 70),
+			Expected:    strings.Repeat("a", 64),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.DisplayName, func(t *testing.T) {
+			if result := groupSanitizeMailNickname(tc.DisplayName); result != tc.Expected {
+				t.Fatalf("Expected groupSanitizeMailNickname(%q) to return %q, got %q", tc.DisplayName, tc.Expected, result)
+			}
+		})
+	}
+}
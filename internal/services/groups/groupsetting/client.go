@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package groupsetting provides a client for the tenant-wide groupSettings and groupSettingTemplates APIs, which are
+// not yet available in the microsoft-graph SDK.
+package groupsetting
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type GroupSettingClient struct {
+	Client *msgraph.Client
+}
+
+func NewGroupSettingClientWithBaseURI(sdkApi sdkEnv.Api) (*GroupSettingClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "groupsetting", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating GroupSettingClient: %+v", err)
+	}
+
+	return &GroupSettingClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsetting
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GroupSettingId is a struct representing the Resource ID for a Group Setting
+type GroupSettingId struct {
+	GroupSettingId string
+}
+
+// NewGroupSettingID returns a new GroupSettingId struct
+func NewGroupSettingID(groupSettingId string) GroupSettingId {
+	return GroupSettingId{
+		GroupSettingId: groupSettingId,
+	}
+}
+
+// ParseGroupSettingID parses 'input' into a GroupSettingId
+func ParseGroupSettingID(input string) (*GroupSettingId, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 2 || segments[0] != "groupSettings" || segments[1] == "" {
+		return nil, fmt.Errorf("parsing %q: expected an ID in the format /groupSettings/{groupSettingId}", input)
+	}
+
+	return &GroupSettingId{
+		GroupSettingId: segments[1],
+	}, nil
+}
+
+// ValidateGroupSettingID checks that 'input' can be parsed as a Group Setting ID
+func ValidateGroupSettingID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseGroupSettingID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Group Setting ID
+func (id GroupSettingId) ID() string {
+	return fmt.Sprintf("/groupSettings/%s", id.GroupSettingId)
+}
+
+// String returns a human-readable description of this Group Setting ID
+func (id GroupSettingId) String() string {
+	return fmt.Sprintf("Group Setting (Group Setting: %q)", id.GroupSettingId)
+}
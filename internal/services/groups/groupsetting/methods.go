@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groupsetting
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type OperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.GroupSetting
+}
+
+type ListOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.GroupSetting
+}
+
+type GetTemplateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *stable.GroupSettingTemplate
+}
+
+type operationOptions struct{}
+
+func (o operationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o operationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o operationOptions) ToQuery() *client.QueryParams {
+	return &client.QueryParams{}
+}
+
+// ListGroupSettings - List settings. Retrieve a list of tenant-level group settings objects.
+func (c GroupSettingClient) ListGroupSettings(ctx context.Context) (result ListOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, "/groupSettings", []int{http.StatusOK}, nil)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]stable.GroupSetting `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
+
+// CreateGroupSetting - Create settings. Create a new tenant-level setting based on the templates available in
+// groupSettingTemplates.
+func (c GroupSettingClient) CreateGroupSetting(ctx context.Context, input stable.GroupSetting) (result OperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPost, "/groupSettings", []int{http.StatusCreated, http.StatusOK}, &input)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.GroupSetting
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// GetGroupSetting - Get groupSetting. Retrieve the properties of a specific tenant-level group setting object.
+func (c GroupSettingClient) GetGroupSetting(ctx context.Context, id GroupSettingId) (result OperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, id.ID(), []int{http.StatusOK}, nil)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.GroupSetting
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdateGroupSetting - Update groupSetting. Update the properties of a specific tenant-level group setting object.
+func (c GroupSettingClient) UpdateGroupSetting(ctx context.Context, id GroupSettingId, input stable.GroupSetting) (result OperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPatch, id.ID(), []int{http.StatusAccepted, http.StatusNoContent, http.StatusOK}, &input)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// DeleteGroupSetting - Delete groupSetting. Delete a tenant-level group setting object.
+func (c GroupSettingClient) DeleteGroupSetting(ctx context.Context, id GroupSettingId) (result OperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodDelete, id.ID(), []int{http.StatusNoContent, http.StatusOK}, nil)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// GetGroupSettingTemplate - Get a group setting template. Retrieve the properties of a groupSettingTemplate object,
+// including the available settings and their defaults.
+func (c GroupSettingClient) GetGroupSettingTemplate(ctx context.Context, templateId string) (result GetTemplateOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("/groupSettingTemplates/%s", templateId), []int{http.StatusOK}, nil)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model stable.GroupSettingTemplate
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c GroupSettingClient) execute(ctx context.Context, method, path string, expectedStatusCodes []int, input *stable.GroupSetting) (*client.Response, error) {
+	opts := client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		OptionsObject:       operationOptions{},
+		Path:                path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if input != nil {
+		if err = req.Marshal(*input); err != nil {
+			return nil, err
+		}
+	}
+
+	return req.Execute(ctx)
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import "fmt"
+
+type GroupLicenseId struct {
+	ObjectSubResourceId
+	GroupId string
+	SkuId   string
+}
+
+func NewGroupLicenseID(groupId, skuId string) GroupLicenseId {
+	return GroupLicenseId{
+		ObjectSubResourceId: NewObjectSubResourceID(groupId, "license", skuId),
+		GroupId:             groupId,
+		SkuId:               skuId,
+	}
+}
+
+func GroupLicenseID(idString string) (*GroupLicenseId, error) {
+	id, err := ObjectSubResourceID(idString, "license")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse License ID: %v", err)
+	}
+
+	return &GroupLicenseId{
+		ObjectSubResourceId: *id,
+		GroupId:             id.objectId,
+		SkuId:               id.subId,
+	}, nil
+}
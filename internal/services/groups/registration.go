@@ -35,7 +35,12 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_group":        groupResource(),
-		"azuread_group_member": groupMemberResource(),
+		"azuread_directory_setting":        directorySettingResource(),
+		"azuread_group":                    groupResource(),
+		"azuread_group_license_assignment": groupLicenseAssignmentResource(),
+		"azuread_group_lifecycle_policy":   groupLifecyclePolicyResource(),
+		"azuread_group_member":             groupMemberResource(),
+		"azuread_group_owner":              groupOwnerResource(),
+		"azuread_group_setting":            groupSettingResource(),
 	}
 }
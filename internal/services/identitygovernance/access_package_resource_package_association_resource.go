@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackage"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackageaccesspackageresourcerolescope"
@@ -94,7 +95,7 @@ func accessPackageResourcePackageAssociationResourceCreate(ctx context.Context,
 	}
 
 	if resourceResp.Model == nil || len(*resourceResp.Model) == 0 {
-		return tf.ErrorDiagF(errors.New("no matching resource found"), "Retrieving Access Package Resources for %s", catalogId)
+		return tf.ErrorDiagPathF(errors.New("no matching resource found"), "catalog_resource_association_id", "Resource %q is not associated with %s", catalogResourceAssociationId.OriginId, catalogId)
 	}
 
 	resource := pointer.To((*resourceResp.Model)[0])
@@ -194,6 +195,7 @@ func accessPackageResourcePackageAssociationResourceRead(ctx context.Context, d
 
 func accessPackageResourcePackageAssociationResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).IdentityGovernance.AccessPackageResourceRoleScopeClient
+	accessPackageClient := meta.(*clients.Client).IdentityGovernance.AccessPackageClient
 
 	resourceId, err := parse.AccessPackageResourcePackageAssociationID(d.Id())
 	if err != nil {
@@ -202,9 +204,24 @@ func accessPackageResourcePackageAssociationResourceDelete(ctx context.Context,
 
 	id := beta.NewIdentityGovernanceEntitlementManagementAccessPackageIdAccessPackageResourceRoleScopeID(resourceId.AccessPackageId, resourceId.ResourceRoleScopeId)
 
-	if _, err = client.DeleteEntitlementManagementAccessPackageResourceRoleScope(ctx, id, entitlementmanagementaccesspackageaccesspackageresourcerolescope.DefaultDeleteEntitlementManagementAccessPackageResourceRoleScopeOperationOptions()); err != nil {
+	if resp, err := client.DeleteEntitlementManagementAccessPackageResourceRoleScope(ctx, id, entitlementmanagementaccesspackageaccesspackageresourcerolescope.DefaultDeleteEntitlementManagementAccessPackageResourceRoleScopeOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
 		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
 	}
 
+	// Wait for the role scope to be removed from the access package
+	if err = consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		roleScope, err := GetAccessPackageResourcesRoleScope(ctx, accessPackageClient, id)
+		if err != nil {
+			return nil, err
+		}
+		return pointer.To(roleScope != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
 	return nil
 }
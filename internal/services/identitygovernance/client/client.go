@@ -23,6 +23,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupeligibilityschedule"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupeligibilityscheduleinstance"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/privilegedaccessgroupeligibilityschedulerequest"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreement"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreementfile"
 )
 
 type Client struct {
@@ -41,6 +43,8 @@ type Client struct {
 	PrivilegedAccessGroupEligibilityScheduleClient         *privilegedaccessgroupeligibilityschedule.PrivilegedAccessGroupEligibilityScheduleClient
 	PrivilegedAccessGroupEligibilityScheduleInstanceClient *privilegedaccessgroupeligibilityscheduleinstance.PrivilegedAccessGroupEligibilityScheduleInstanceClient
 	PrivilegedAccessGroupEligibilityScheduleRequestClient  *privilegedaccessgroupeligibilityschedulerequest.PrivilegedAccessGroupEligibilityScheduleRequestClient
+	TermsOfUseAgreementClient                              *termsofuseagreement.TermsOfUseAgreementClient
+	TermsOfUseAgreementFileClient                          *termsofuseagreementfile.TermsOfUseAgreementFileClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -128,6 +132,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(privilegedAccessGroupEligibilityScheduleRequestClient.Client)
 
+	termsOfUseAgreementClient, err := termsofuseagreement.NewTermsOfUseAgreementClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(termsOfUseAgreementClient.Client)
+
+	termsOfUseAgreementFileClient, err := termsofuseagreementfile.NewTermsOfUseAgreementFileClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(termsOfUseAgreementFileClient.Client)
+
 	return &Client{
 		AccessPackageAssignmentPolicyClient:  accessPackageAssignmentPolicyClient,
 		AccessPackageCatalogClient:           accessPackageCatalogClient,
@@ -144,5 +160,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		PrivilegedAccessGroupEligibilityScheduleClient:         privilegedAccessGroupEligibilityScheduleClient,
 		PrivilegedAccessGroupEligibilityScheduleInstanceClient: privilegedAccessGroupEligibilityScheduleInstanceClient,
 		PrivilegedAccessGroupEligibilityScheduleRequestClient:  privilegedAccessGroupEligibilityScheduleRequestClient,
+		TermsOfUseAgreementClient:                              termsOfUseAgreementClient,
+		TermsOfUseAgreementFileClient:                          termsOfUseAgreementFileClient,
 	}, nil
 }
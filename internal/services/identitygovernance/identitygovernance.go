@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/beta"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/beta/entitlementmanagementaccesspackage"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
@@ -26,6 +27,10 @@ func GetAccessPackageResourcesRoleScope(ctx context.Context, client *entitlement
 	}
 	resp, err := client.GetEntitlementManagementAccessPackage(ctx, accessPackageId, options)
 	if err != nil {
+		// The role scope cannot exist without its access package
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("retrieving %s: %v", accessPackageId, err)
 	}
 
@@ -33,8 +38,9 @@ func GetAccessPackageResourcesRoleScope(ctx context.Context, client *entitlement
 		return nil, fmt.Errorf("retrieving %s: model was nil", accessPackageId)
 	}
 
+	// The field is omitted when the access package has no role scopes
 	if resp.Model.AccessPackageResourceRoleScopes == nil {
-		return nil, fmt.Errorf("retrieving %s: AccessPackageResourceRoleScopes was nil", accessPackageId)
+		return nil, nil
 	}
 
 	// There is only a select and expand method on this endpoint, we iterate the result to find the RoleScope
@@ -45,6 +45,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azuread_access_package_catalog_role_assignment":      accessPackageCatalogRoleAssignmentResource(),
 		"azuread_access_package_resource_catalog_association": accessPackageResourceCatalogAssociationResource(),
 		"azuread_access_package_resource_package_association": accessPackageResourcePackageAssociationResource(),
+		"azuread_terms_of_use_agreement":                      termsOfUseAgreementResource(),
 	}
 }
 
@@ -0,0 +1,503 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreement"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreementfile"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/identitygovernance/validate"
+)
+
+func termsOfUseAgreementResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: termsOfUseAgreementResourceCreate,
+		ReadContext:   termsOfUseAgreementResourceRead,
+		UpdateContext: termsOfUseAgreementResourceUpdate,
+		DeleteContext: termsOfUseAgreementResourceDelete,
+
+		CustomizeDiff: termsOfUseAgreementCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, err := uuid.ParseUUID(id); err != nil {
+				return fmt.Errorf("specified ID (%q) is not valid: %s", id, err)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"display_name": {
+				Description:  "The display name of the agreement, used for internal tracking and not shown to end users",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"file": {
+				Description: "One or more PDF documents for the agreement, each in a different language. Files for additional languages can be added in place, but changing or removing a file recreates the agreement",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"content": {
+							Description:      "The base64 encoded content of the PDF document",
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							ValidateDiagFunc: validate.PdfDocument,
+						},
+
+						"default": {
+							Description: "Whether this is the default document, shown when none of the languages match the user's preference",
+							Type:        pluginsdk.TypeBool,
+							Optional:    true,
+							Computed:    true,
+						},
+
+						"display_name": {
+							Description:  "The localized display name of the agreement, shown to end users",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"file_name": {
+							Description:  "The file name of the PDF document, e.g. `TOU.pdf`",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"language": {
+							Description:  "The language of the PDF document, e.g. `en-US`",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.ISO639Language,
+						},
+					},
+				},
+			},
+
+			"per_device_acceptance_required": {
+				Description: "Whether end users are required to accept the agreement on every device they access it from",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"terms_expiration": {
+				Description: "The expiration schedule of the agreement for all users",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"frequency": {
+							Description:  "The frequency at which the agreement expires after the start date, as an ISO8601 duration, e.g. `P90D`",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.ISO8601Duration,
+						},
+
+						"start_date": {
+							Description:  "The date and time when the agreement first expires for all users, formatted as an RFC3339 date string",
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+							DiffSuppressFunc: func(_, old, new string, _ *pluginsdk.ResourceData) bool {
+								oldTime, err := time.Parse(time.RFC3339, old)
+								if err != nil {
+									return false
+								}
+								newTime, err := time.Parse(time.RFC3339, new)
+								if err != nil {
+									return false
+								}
+								return oldTime.Equal(newTime)
+							},
+						},
+					},
+				},
+			},
+
+			"user_reaccept_required_frequency": {
+				Description:  "The duration after which users must reaccept the agreement, as an ISO8601 duration, e.g. `P90D`",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ISO8601Duration,
+			},
+
+			"view_before_acceptance_required": {
+				Description: "Whether users must expand the agreement before accepting it",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func termsOfUseAgreementCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	languages := make(map[string]bool)
+	defaults := 0
+
+	for _, raw := range diff.Get("file").([]interface{}) {
+		if raw == nil {
+			continue
+		}
+		file := raw.(map[string]interface{})
+
+		language := strings.ToLower(file["language"].(string))
+		if language == "" {
+			continue
+		}
+		if languages[language] {
+			return fmt.Errorf("only one `file` block may be specified for the language %q", file["language"].(string))
+		}
+		languages[language] = true
+
+		if file["default"].(bool) {
+			defaults++
+		}
+	}
+
+	if defaults > 1 {
+		return fmt.Errorf("at most one `file` block may be marked as `default`")
+	}
+
+	// Files for additional languages can be added to an existing agreement, but existing files cannot be changed or
+	// removed, so the agreement must be recreated in that case
+	if diff.Id() != "" && diff.HasChange("file") {
+		oldFiles, newFiles := diff.GetChange("file")
+		if termsOfUseAgreementFilesChanged(oldFiles.([]interface{}), newFiles.([]interface{})) {
+			return diff.ForceNew("file")
+		}
+	}
+
+	return nil
+}
+
+// termsOfUseAgreementFilesChanged returns whether any of the existing agreement files, identified by their language,
+// were removed or modified
+func termsOfUseAgreementFilesChanged(oldFiles, newFiles []interface{}) bool {
+	files := make(map[string]map[string]interface{})
+	for _, raw := range newFiles {
+		if raw == nil {
+			continue
+		}
+		file := raw.(map[string]interface{})
+		files[strings.ToLower(file["language"].(string))] = file
+	}
+
+	for _, raw := range oldFiles {
+		if raw == nil {
+			continue
+		}
+		oldFile := raw.(map[string]interface{})
+
+		newFile, ok := files[strings.ToLower(oldFile["language"].(string))]
+		if !ok {
+			return true
+		}
+
+		for _, k := range []string{"content", "default", "display_name", "file_name"} {
+			if oldFile[k] != newFile[k] {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func termsOfUseAgreementResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementClient
+
+	properties := expandTermsOfUseAgreement(d)
+	properties.Files = expandTermsOfUseAgreementFiles(d.Get("file").([]interface{}))
+
+	resp, err := client.CreateTermsOfUseAgreement(ctx, properties, termsofuseagreement.DefaultCreateTermsOfUseAgreementOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Creating terms of use agreement %q", d.Get("display_name").(string))
+	}
+
+	agreement := resp.Model
+	if agreement == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Creating terms of use agreement")
+	}
+	if agreement.Id == nil {
+		return tf.ErrorDiagF(errors.New("model returned with nil ID"), "Creating terms of use agreement")
+	}
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(*agreement.Id)
+	d.SetId(id.AgreementId)
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetTermsOfUseAgreement(ctx, id, termsofuseagreement.DefaultGetTermsOfUseAgreementOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return termsOfUseAgreementResourceRead(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementClient
+	fileClient := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementFileClient
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(d.Id())
+
+	properties := expandTermsOfUseAgreement(d)
+
+	// The expiration schedule must be explicitly nulled in order to remove it
+	if d.HasChange("terms_expiration") && properties.TermsExpiration == nil {
+		properties.TermsExpiration = &stable.TermsExpiration{
+			Frequency:     nullable.NoZero(""),
+			StartDateTime: nullable.NoZero(""),
+		}
+	}
+
+	if _, err := client.UpdateTermsOfUseAgreement(ctx, id, properties, termsofuseagreement.DefaultUpdateTermsOfUseAgreementOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	// Any other changes to the files force a new resource, so only files for additional languages need to be added
+	if d.HasChange("file") {
+		oldFiles, _ := d.GetChange("file")
+		existing := make(map[string]bool)
+		for _, raw := range oldFiles.([]interface{}) {
+			if raw != nil {
+				existing[strings.ToLower(raw.(map[string]interface{})["language"].(string))] = true
+			}
+		}
+
+		for _, raw := range d.Get("file").([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			file := raw.(map[string]interface{})
+			if existing[strings.ToLower(file["language"].(string))] {
+				continue
+			}
+
+			if _, err := fileClient.CreateTermsOfUseAgreementFile(ctx, id, expandTermsOfUseAgreementFile(file), termsofuseagreementfile.DefaultCreateTermsOfUseAgreementFileOperationOptions()); err != nil {
+				return tf.ErrorDiagPathF(err, "file", "Adding file for language %q to %s", file["language"].(string), id)
+			}
+		}
+	}
+
+	return termsOfUseAgreementResourceRead(ctx, d, meta)
+}
+
+func termsOfUseAgreementResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementClient
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(d.Id())
+
+	options := termsofuseagreement.GetTermsOfUseAgreementOperationOptions{
+		Expand: &odata.Expand{Relationship: "files"},
+	}
+
+	resp, err := client.GetTermsOfUseAgreement(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	agreement := resp.Model
+	if agreement == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "display_name", agreement.DisplayName.GetOrZero())
+	tf.Set(d, "file", flattenTermsOfUseAgreementFiles(agreement.Files, d.Get("file").([]interface{})))
+	tf.Set(d, "per_device_acceptance_required", agreement.IsPerDeviceAcceptanceRequired.GetOrZero())
+	tf.Set(d, "terms_expiration", flattenTermsOfUseAgreementTermsExpiration(agreement.TermsExpiration))
+	tf.Set(d, "user_reaccept_required_frequency", agreement.UserReacceptRequiredFrequency.GetOrZero())
+	tf.Set(d, "view_before_acceptance_required", agreement.IsViewingBeforeAcceptanceRequired.GetOrZero())
+
+	return nil
+}
+
+func termsOfUseAgreementResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).IdentityGovernance.TermsOfUseAgreementClient
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(d.Id())
+
+	if resp, err := client.DeleteTermsOfUseAgreement(ctx, id, termsofuseagreement.DefaultDeleteTermsOfUseAgreementOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s already deleted", id)
+			return nil
+		}
+
+		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetTermsOfUseAgreement(ctx, id, termsofuseagreement.DefaultGetTermsOfUseAgreementOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
+
+func expandTermsOfUseAgreement(d *pluginsdk.ResourceData) stable.Agreement {
+	return stable.Agreement{
+		DisplayName:                       nullable.Value(d.Get("display_name").(string)),
+		IsPerDeviceAcceptanceRequired:     nullable.Value(d.Get("per_device_acceptance_required").(bool)),
+		IsViewingBeforeAcceptanceRequired: nullable.Value(d.Get("view_before_acceptance_required").(bool)),
+		TermsExpiration:                   expandTermsOfUseAgreementTermsExpiration(d.Get("terms_expiration").([]interface{})),
+		UserReacceptRequiredFrequency:     nullable.NoZero(d.Get("user_reaccept_required_frequency").(string)),
+	}
+}
+
+func expandTermsOfUseAgreementFiles(in []interface{}) *[]stable.AgreementFileLocalization {
+	result := make([]stable.AgreementFileLocalization, 0)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		result = append(result, expandTermsOfUseAgreementFile(raw.(map[string]interface{})))
+	}
+
+	return &result
+}
+
+func expandTermsOfUseAgreementFile(file map[string]interface{}) stable.AgreementFileLocalization {
+	return stable.AgreementFileLocalization{
+		DisplayName: nullable.Value(file["display_name"].(string)),
+		FileData: &stable.AgreementFileData{
+			Data: nullable.Value(file["content"].(string)),
+		},
+		FileName:  nullable.Value(file["file_name"].(string)),
+		IsDefault: nullable.Value(file["default"].(bool)),
+		Language:  nullable.Value(file["language"].(string)),
+	}
+}
+
+// flattenTermsOfUseAgreementFiles returns the agreement files in the same order as the existing configuration, since the
+// API does not return the content of the files, which is instead retained from the configuration
+func flattenTermsOfUseAgreementFiles(in *[]stable.AgreementFileLocalization, existing []interface{}) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	files := make(map[string]stable.AgreementFileLocalization)
+	languages := make([]string, 0)
+	for _, file := range *in {
+		language := strings.ToLower(file.Language.GetOrZero())
+		files[language] = file
+		languages = append(languages, language)
+	}
+
+	flatten := func(file stable.AgreementFileLocalization, content, language string) map[string]interface{} {
+		return map[string]interface{}{
+			"content":      content,
+			"default":      file.IsDefault.GetOrZero(),
+			"display_name": file.DisplayName.GetOrZero(),
+			"file_name":    file.FileName.GetOrZero(),
+			"language":     language,
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, raw := range existing {
+		if raw == nil {
+			continue
+		}
+		config := raw.(map[string]interface{})
+
+		// The API may return the language with different casing, so retain the configured value to avoid replacement
+		language := strings.ToLower(config["language"].(string))
+		if file, ok := files[language]; ok && !seen[language] {
+			result = append(result, flatten(file, config["content"].(string), config["language"].(string)))
+			seen[language] = true
+		}
+	}
+
+	for _, language := range languages {
+		if !seen[language] {
+			result = append(result, flatten(files[language], "", files[language].Language.GetOrZero()))
+			seen[language] = true
+		}
+	}
+
+	return result
+}
+
+func expandTermsOfUseAgreementTermsExpiration(in []interface{}) *stable.TermsExpiration {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+	config := in[0].(map[string]interface{})
+
+	return &stable.TermsExpiration{
+		Frequency:     nullable.Value(config["frequency"].(string)),
+		StartDateTime: nullable.Value(config["start_date"].(string)),
+	}
+}
+
+func flattenTermsOfUseAgreementTermsExpiration(in *stable.TermsExpiration) []interface{} {
+	if in == nil || (in.Frequency.GetOrZero() == "" && in.StartDateTime.GetOrZero() == "") {
+		return []interface{}{}
+	}
+
+	startDate := in.StartDateTime.GetOrZero()
+	if t, err := time.Parse(time.RFC3339, startDate); err == nil {
+		startDate = t.UTC().Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"frequency":  in.Frequency.GetOrZero(),
+			"start_date": startDate,
+		},
+	}
+}
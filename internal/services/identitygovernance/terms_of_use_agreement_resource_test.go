@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package identitygovernance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/identitygovernance/stable/termsofuseagreement"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+// A minimal single page PDF document
+const termsOfUseAgreementPdf = "JVBERi0xLjQKMSAwIG9iago8PCAvVHlwZSAvQ2F0YWxvZyAvUGFnZXMgMiAwIFIgPj4KZW5kb2JqCjIgMCBvYmoKPDwgL1R5cGUgL1BhZ2VzIC9LaWRzIFszIDAgUl0gL0NvdW50IDEgPj4KZW5kb2JqCjMgMCBvYmoKPDwgL1R5cGUgL1BhZ2UgL1BhcmVudCAyIDAgUiAvTWVkaWFCb3ggWzAgMCAyMDAgMjAwXSA+PgplbmRvYmoKeHJlZgowIDQKMDAwMDAwMDAwMCA2NTUzNSBmIAowMDAwMDAwMDA5IDAwMDAwIG4gCjAwMDAwMDAwNTggMDAwMDAgbiAKMDAwMDAwMDExNSAwMDAwMCBuIAp0cmFpbGVyCjw8IC9TaXplIDQgL1Jvb3QgMSAwIFIgPj4Kc3RhcnR4cmVmCjE4NgolJUVPRgo="
+
+type TermsOfUseAgreementResource struct{}
+
+func TestAccTermsOfUseAgreement_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("file.0.content"),
+	})
+}
+
+func TestAccTermsOfUseAgreement_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	startDate := time.Now().AddDate(0, 1, 0).UTC().Truncate(24 * time.Hour).Format(time.RFC3339)
+	r := TermsOfUseAgreementResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("file.0.content"),
+		{
+			Config: r.complete(data, startDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.#").HasValue("2"),
+				check.That(data.ResourceName).Key("terms_expiration.0.frequency").HasValue("P90D"),
+			),
+		},
+		data.ImportStep("file.0.content", "file.1.content"),
+		{
+			Config: r.completeUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("terms_expiration.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("file.0.content", "file.1.content"),
+	})
+}
+
+func TestAccTermsOfUseAgreement_files(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_terms_of_use_agreement", "test")
+	r := TermsOfUseAgreementResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("file.0.content"),
+		{
+			Config: r.additionalFile(data, "Conditions d'utilisation"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(data.ResourceName, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("file.0.content", "file.1.content"),
+		{
+			Config: r.additionalFile(data, "Conditions générales"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(data.ResourceName, plancheck.ResourceActionReplace),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("file.0.content", "file.1.content"),
+		{
+			Config: r.basic(data),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(data.ResourceName, plancheck.ResourceActionReplace),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("file.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("file.0.content"),
+	})
+}
+
+func (r TermsOfUseAgreementResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.IdentityGovernance.TermsOfUseAgreementClient
+
+	id := stable.NewIdentityGovernanceTermsOfUseAgreementID(state.ID)
+
+	resp, err := client.GetTermsOfUseAgreement(ctx, id, termsofuseagreement.DefaultGetTermsOfUseAgreementOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (TermsOfUseAgreementResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name = "acctestToU-%[1]d"
+
+  file {
+    content      = "%[2]s"
+    display_name = "Terms of Use"
+    file_name    = "TOU.pdf"
+    language     = "en-US"
+  }
+}
+`, data.RandomInteger, termsOfUseAgreementPdf)
+}
+
+func (TermsOfUseAgreementResource) complete(data acceptance.TestData, startDate string) string {
+	return fmt.Sprintf(`
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name                     = "acctestToU-complete-%[1]d"
+  per_device_acceptance_required   = true
+  user_reaccept_required_frequency = "P30D"
+  view_before_acceptance_required  = true
+
+  file {
+    content      = "%[2]s"
+    default      = true
+    display_name = "Terms of Use"
+    file_name    = "TOU.pdf"
+    language     = "en-US"
+  }
+
+  file {
+    content      = "%[2]s"
+    display_name = "Conditions d'utilisation"
+    file_name    = "TOU-fr.pdf"
+    language     = "fr-FR"
+  }
+
+  terms_expiration {
+    frequency  = "P90D"
+    start_date = "%[3]s"
+  }
+}
+`, data.RandomInteger, termsOfUseAgreementPdf, startDate)
+}
+
+func (TermsOfUseAgreementResource) completeUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name = "acctestToU-updated-%[1]d"
+
+  file {
+    content      = "%[2]s"
+    default      = true
+    display_name = "Terms of Use"
+    file_name    = "TOU.pdf"
+    language     = "en-US"
+  }
+
+  file {
+    content      = "%[2]s"
+    display_name = "Conditions d'utilisation"
+    file_name    = "TOU-fr.pdf"
+    language     = "fr-FR"
+  }
+}
+`, data.RandomInteger, termsOfUseAgreementPdf)
+}
+
+func (TermsOfUseAgreementResource) additionalFile(data acceptance.TestData, frenchDisplayName string) string {
+	return fmt.Sprintf(`
+resource "azuread_terms_of_use_agreement" "test" {
+  display_name = "acctestToU-%[1]d"
+
+  file {
+    content      = "%[2]s"
+    display_name = "Terms of Use"
+    file_name    = "TOU.pdf"
+    language     = "en-US"
+  }
+
+  file {
+    content      = "%[2]s"
+    display_name = "%[3]s"
+    file_name    = "TOU-fr.pdf"
+    language     = "fr-FR"
+  }
+}
+`, data.RandomInteger, termsOfUseAgreementPdf, frenchDisplayName)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"bytes"
+	"encoding/base64"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// PdfDocument checks whether a value is a base64 encoded PDF document, by looking for the `%PDF` header in the decoded
+// content.
+func PdfDocument(i interface{}, path cty.Path) (ret pluginsdk.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	data, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Value must be a base64 encoded PDF document",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+		return
+	}
+
+	if !bytes.HasPrefix(data, []byte("%PDF")) {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Value must be a base64 encoded PDF document",
+			Detail:        "The decoded content does not begin with a PDF header",
+			AttributePath: path,
+		})
+	}
+
+	return // nolint:nakedret
+}
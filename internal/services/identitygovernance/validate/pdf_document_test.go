@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestPdfDocument(t *testing.T) {
+	cases := []struct {
+		Value    interface{}
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    base64.StdEncoding.EncodeToString([]byte("%PDF-1.7\n%\xE2\xE3\xCF\xD3\n")),
+			TestName: "Valid",
+			ErrCount: 0,
+		},
+		{
+			Value:    "not base64!",
+			TestName: "Invalid_NotBase64",
+			ErrCount: 1,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString([]byte("Hello, World!")),
+			TestName: "Invalid_NotPdf",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			TestName: "Invalid_Empty",
+			ErrCount: 1,
+		},
+		{
+			Value:    123,
+			TestName: "Invalid_NotString",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := PdfDocument(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected PdfDocument to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}
@@ -101,6 +101,13 @@ func invitationResource() *pluginsdk.Resource {
 				},
 			},
 
+			"send_invitation_message": {
+				Description: "Whether an email should be sent to the user being invited. Defaults to `true` when a `message` block is specified",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+
 			"user_type": {
 				Description:  "The user type of the user being invited",
 				Type:         pluginsdk.TypeString,
@@ -144,6 +151,10 @@ func invitationResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, me
 		properties.InvitedUserMessageInfo = expandInvitedUserMessageInfo(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOkExists("send_invitation_message"); ok { //nolint:staticcheck // needed to detect unset booleans
+		properties.SendInvitationMessage = nullable.Value(v.(bool))
+	}
+
 	resp, err := client.CreateInvitation(ctx, properties, invitation.DefaultCreateInvitationOperationOptions())
 	if err != nil {
 		return tf.ErrorDiagF(err, "Creating invitation")
@@ -215,7 +226,7 @@ func invitationResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] Invited %s was not found - removing from state!", userId)
-			d.Set("user_id", "")
+			d.SetId("")
 			return nil
 		}
 		return tf.ErrorDiagF(err, "Retrieving invited %s", userId)
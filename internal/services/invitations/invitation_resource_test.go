@@ -79,6 +79,24 @@ func TestAccInvitation_message(t *testing.T) {
 	})
 }
 
+func TestAccInvitation_messageNotSent(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_invitation", "test")
+	r := InvitationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withMessageNotSent(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("redeem_url").Exists(),
+				check.That(data.ResourceName).Key("user_id").IsUuid(),
+				check.That(data.ResourceName).Key("message.#").HasValue("1"),
+				check.That(data.ResourceName).Key("send_invitation_message").HasValue("false"),
+			),
+		},
+	})
+}
+
 func TestAccInvitation_messageWithCustomizedBody(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_invitation", "test")
 	r := InvitationResource{}
@@ -186,6 +204,20 @@ resource "azuread_invitation" "test" {
 `, data.RandomString)
 }
 
+func (InvitationResource) withMessageNotSent(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_invitation" "test" {
+  redirect_url            = "https://portal.azure.com"
+  user_email_address      = "acctest-user-%[1]s@test.com"
+  send_invitation_message = false
+
+  message {
+    language = "fr-CA"
+  }
+}
+`, data.RandomString)
+}
+
 func (InvitationResource) withMessageHavingCustomizedBody(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_invitation" "test" {
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/appmanagementpolicy"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func appManagementPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: appManagementPolicyResourceCreate,
+		ReadContext:   appManagementPolicyResourceRead,
+		UpdateContext: appManagementPolicyResourceUpdate,
+		DeleteContext: appManagementPolicyResourceDelete,
+
+		CustomizeDiff: appManagementPolicyResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidatePolicyAppManagementPolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"description": {
+				Description:  "Description for this policy",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"display_name": {
+				Description:  "Display name for this policy",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"enabled": {
+				Description: "Whether the policy is enabled",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+
+			"key_credential": appManagementPolicyCredentialRestrictionSchema("A key credential restriction for this policy", stable.PossibleValuesForAppKeyCredentialRestrictionType()),
+
+			"password_credential": appManagementPolicyCredentialRestrictionSchema("A password credential restriction for this policy", stable.PossibleValuesForAppCredentialRestrictionType()),
+		},
+	}
+}
+
+func appManagementPolicyResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	for _, block := range []string{"key_credential", "password_credential"} {
+		for i, raw := range diff.Get(block).([]interface{}) {
+			if raw == nil {
+				continue
+			}
+
+			path := fmt.Sprintf("%s.%d", block, i)
+			if !diff.NewValueKnown(path+".restriction_type") || !diff.NewValueKnown(path+".max_lifetime") {
+				continue
+			}
+
+			restriction := raw.(map[string]interface{})
+			if err := validateAppManagementPolicyCredentialRestriction(restriction["restriction_type"].(string), restriction["max_lifetime"].(string)); err != nil {
+				return fmt.Errorf("`%s`: %v", path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func appManagementPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	properties := stable.AppManagementPolicy{
+		Description:  nullable.Value(d.Get("description").(string)),
+		DisplayName:  nullable.Value(d.Get("display_name").(string)),
+		IsEnabled:    pointer.To(d.Get("enabled").(bool)),
+		Restrictions: expandAppManagementPolicyRestrictions(d.Get("password_credential").([]interface{}), d.Get("key_credential").([]interface{})),
+	}
+
+	resp, err := client.CreateAppManagementPolicy(ctx, properties, appmanagementpolicy.DefaultCreateAppManagementPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create App Management Policy")
+	}
+
+	appManagementPolicy := resp.Model
+	if appManagementPolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not create App Management Policy")
+	}
+	if appManagementPolicy.Id == nil {
+		return tf.ErrorDiagF(errors.New("model return with nil ID"), "Could not create App Management Policy")
+	}
+
+	id := stable.NewPolicyAppManagementPolicyID(*appManagementPolicy.Id)
+	d.SetId(id.ID())
+
+	return appManagementPolicyResourceRead(ctx, d, meta)
+}
+
+func appManagementPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	id, err := stable.ParsePolicyAppManagementPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetAppManagementPolicy(ctx, *id, appmanagementpolicy.DefaultGetAppManagementPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "retrieving %s", id)
+	}
+
+	appManagementPolicy := resp.Model
+	if appManagementPolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "description", appManagementPolicy.Description.GetOrZero())
+	tf.Set(d, "display_name", appManagementPolicy.DisplayName.GetOrZero())
+	tf.Set(d, "enabled", pointer.From(appManagementPolicy.IsEnabled))
+	tf.Set(d, "key_credential", flattenAppManagementPolicyKeyCredentials(appManagementPolicy.Restrictions))
+	tf.Set(d, "password_credential", flattenAppManagementPolicyPasswordCredentials(appManagementPolicy.Restrictions))
+
+	return nil
+}
+
+func appManagementPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	id, err := stable.ParsePolicyAppManagementPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	properties := stable.AppManagementPolicy{
+		Description:  nullable.Value(d.Get("description").(string)),
+		DisplayName:  nullable.Value(d.Get("display_name").(string)),
+		IsEnabled:    pointer.To(d.Get("enabled").(bool)),
+		Restrictions: expandAppManagementPolicyRestrictions(d.Get("password_credential").([]interface{}), d.Get("key_credential").([]interface{})),
+	}
+
+	if _, err := client.UpdateAppManagementPolicy(ctx, *id, properties, appmanagementpolicy.DefaultUpdateAppManagementPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update %s", id)
+	}
+
+	return appManagementPolicyResourceRead(ctx, d, meta)
+}
+
+func appManagementPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.AppManagementPolicyClient
+
+	id, err := stable.ParsePolicyAppManagementPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if _, err := client.DeleteAppManagementPolicy(ctx, *id, appmanagementpolicy.DefaultDeleteAppManagementPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	return nil
+}
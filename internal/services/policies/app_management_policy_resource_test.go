@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/appmanagementpolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type AppManagementPolicyResource struct{}
+
+func TestAppManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+	r := AppManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAppManagementPolicy_invalidRestrictions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_app_management_policy", "test")
+	r := AppManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidRestrictions(data),
+			ExpectError: regexp.MustCompile("`max_lifetime` must be specified"),
+		},
+	})
+}
+
+func (r AppManagementPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.AppManagementPolicyClient
+
+	id, err := stable.ParsePolicyAppManagementPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAppManagementPolicy(ctx, *id, appmanagementpolicy.DefaultGetAppManagementPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (AppManagementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctest-%[1]s"
+  description  = "Restricts credential lifetimes"
+
+  password_credential {
+    restriction_type = "passwordLifetime"
+    max_lifetime     = "P90D"
+  }
+}
+`, data.RandomString)
+}
+
+func (AppManagementPolicyResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctest-%[1]s-updated"
+  description  = "Restricts credential lifetimes and custom passwords"
+  enabled      = false
+
+  password_credential {
+    restriction_type = "passwordLifetime"
+    max_lifetime     = "P180D"
+  }
+
+  password_credential {
+    restriction_type                = "customPasswordAddition"
+    restrict_for_apps_created_after = "2020-01-01T00:00:00Z"
+  }
+
+  key_credential {
+    restriction_type = "asymmetricKeyLifetime"
+    max_lifetime     = "P365D"
+  }
+}
+`, data.RandomString)
+}
+
+func (AppManagementPolicyResource) invalidRestrictions(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_app_management_policy" "test" {
+  display_name = "acctest-%[1]s"
+  description  = "Restricts credential lifetimes"
+
+  key_credential {
+    restriction_type = "asymmetricKeyLifetime"
+  }
+}
+`, data.RandomString)
+}
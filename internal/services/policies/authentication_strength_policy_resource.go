@@ -160,6 +160,11 @@ func authenticationStrengthPolicyUpdate(ctx context.Context, d *pluginsdk.Resour
 		Description: nullable.NoZero(d.Get("description").(string)),
 	}
 
+	// Ensure the description is cleared when it has been removed from the configuration
+	if d.HasChange("description") {
+		properties.Description = nullable.Value(d.Get("description").(string))
+	}
+
 	if _, err := client.UpdateAuthenticationStrengthPolicy(ctx, *id, properties, authenticationstrengthpolicy.DefaultUpdateAuthenticationStrengthPolicyOperationOptions()); err != nil {
 		return tf.ErrorDiagF(err, "Could not update %s", id)
 	}
@@ -197,10 +202,12 @@ func authenticationStrengthPolicyRead(ctx context.Context, d *pluginsdk.Resource
 			d.SetId("")
 			return nil
 		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
 	}
+
 	authenticationStrengthPolicy := resp.Model
 	if authenticationStrengthPolicy == nil {
-		return tf.ErrorDiagF(errors.New("Bad API response"), "Result is nil")
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
 	}
 
 	tf.Set(d, "display_name", pointer.From(authenticationStrengthPolicy.DisplayName))
@@ -223,7 +230,11 @@ func authenticationStrengthPolicyDelete(ctx context.Context, d *pluginsdk.Resour
 		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
 	}
 
-	if _, err := client.DeleteAuthenticationStrengthPolicy(ctx, *id, authenticationstrengthpolicy.DefaultDeleteAuthenticationStrengthPolicyOperationOptions()); err != nil {
+	if resp, err := client.DeleteAuthenticationStrengthPolicy(ctx, *id, authenticationstrengthpolicy.DefaultDeleteAuthenticationStrengthPolicyOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
 		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
 	}
 
@@ -62,7 +62,7 @@ func claimsMappingPolicyResource() *pluginsdk.Resource {
 				Required:    true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: validation.All(validation.StringIsNotEmpty, validation.StringIsJSON),
 				},
 			},
 
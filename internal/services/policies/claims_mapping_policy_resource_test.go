@@ -6,6 +6,7 @@ package policies_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -42,6 +43,18 @@ func TestClaimsMappingPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestClaimsMappingPolicy_invalidDefinition(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_claims_mapping_policy", "test")
+	r := ClaimsMappingPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidDefinition(data),
+			ExpectError: regexp.MustCompile("contains an invalid JSON"),
+		},
+	})
+}
+
 func (r ClaimsMappingPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Policies.ClaimsMappingPolicyClient
 
@@ -86,3 +99,16 @@ resource "azuread_claims_mapping_policy" "test" {
 }
 `, data.RandomString)
 }
+
+func (ClaimsMappingPolicyResource) invalidDefinition(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_claims_mapping_policy" "test" {
+  definition = [
+    "{\"ClaimsMappingPolicy\":{\"Version\":1,",
+  ]
+  display_name = "acctest-%[1]s"
+}
+`, data.RandomString)
+}
@@ -4,21 +4,35 @@
 package client
 
 import (
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/appmanagementpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/authenticationstrengthpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/claimsmappingpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/homerealmdiscoverypolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicyassignment"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/tokenissuancepolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/tokenlifetimepolicy"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 type Client struct {
+	AppManagementPolicyClient            *appmanagementpolicy.AppManagementPolicyClient
 	AuthenticationStrengthPolicyClient   *authenticationstrengthpolicy.AuthenticationStrengthPolicyClient
 	ClaimsMappingPolicyClient            *claimsmappingpolicy.ClaimsMappingPolicyClient
+	HomeRealmDiscoveryPolicyClient       *homerealmdiscoverypolicy.HomeRealmDiscoveryPolicyClient
 	RoleManagementPolicyAssignmentClient *rolemanagementpolicyassignment.RoleManagementPolicyAssignmentClient
 	RoleManagementPolicyClient           *rolemanagementpolicy.RoleManagementPolicyClient
+	TokenIssuancePolicyClient            *tokenissuancepolicy.TokenIssuancePolicyClient
+	TokenLifetimePolicyClient            *tokenlifetimepolicy.TokenLifetimePolicyClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	appManagementPolicyClient, err := appmanagementpolicy.NewAppManagementPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(appManagementPolicyClient.Client)
+
 	authenticationStrengthpolicyClient, err := authenticationstrengthpolicy.NewAuthenticationStrengthPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -31,6 +45,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(claimsMappingPolicyClient.Client)
 
+	homeRealmDiscoveryPolicyClient, err := homerealmdiscoverypolicy.NewHomeRealmDiscoveryPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(homeRealmDiscoveryPolicyClient.Client)
+
 	roleManagementPolicyAssignmentClient, err := rolemanagementpolicyassignment.NewRoleManagementPolicyAssignmentClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -43,10 +63,26 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(roleManagementPolicyClient.Client)
 
+	tokenIssuancePolicyClient, err := tokenissuancepolicy.NewTokenIssuancePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(tokenIssuancePolicyClient.Client)
+
+	tokenLifetimePolicyClient, err := tokenlifetimepolicy.NewTokenLifetimePolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(tokenLifetimePolicyClient.Client)
+
 	return &Client{
+		AppManagementPolicyClient:            appManagementPolicyClient,
 		AuthenticationStrengthPolicyClient:   authenticationStrengthpolicyClient,
 		ClaimsMappingPolicyClient:            claimsMappingPolicyClient,
+		HomeRealmDiscoveryPolicyClient:       homeRealmDiscoveryPolicyClient,
 		RoleManagementPolicyAssignmentClient: roleManagementPolicyAssignmentClient,
 		RoleManagementPolicyClient:           roleManagementPolicyClient,
+		TokenIssuancePolicyClient:            tokenIssuancePolicyClient,
+		TokenLifetimePolicyClient:            tokenLifetimePolicyClient,
 	}, nil
 }
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/homerealmdiscoverypolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func homeRealmDiscoveryPolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: homeRealmDiscoveryPolicyResourceCreate,
+		ReadContext:   homeRealmDiscoveryPolicyResourceRead,
+		UpdateContext: homeRealmDiscoveryPolicyResourceUpdate,
+		DeleteContext: homeRealmDiscoveryPolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidatePolicyHomeRealmDiscoveryPolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: stsPolicyResourceSchema("service principals", stsPolicyDefinitionValidateFunc),
+	}
+}
+
+func homeRealmDiscoveryPolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	policy := expandStsPolicy(d)
+	properties := stable.HomeRealmDiscoveryPolicy{
+		Definition:            policy.Definition,
+		Description:           policy.Description,
+		DisplayName:           policy.DisplayName,
+		IsOrganizationDefault: policy.IsOrganizationDefault,
+	}
+
+	resp, err := client.CreateHomeRealmDiscoveryPolicy(ctx, properties, homerealmdiscoverypolicy.DefaultCreateHomeRealmDiscoveryPolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create Home Realm Discovery Policy")
+	}
+
+	homeRealmDiscoveryPolicy := resp.Model
+	if homeRealmDiscoveryPolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not create Home Realm Discovery Policy")
+	}
+	if homeRealmDiscoveryPolicy.Id == nil {
+		return tf.ErrorDiagF(errors.New("model return with nil ID"), "Could not create Home Realm Discovery Policy")
+	}
+
+	id := stable.NewPolicyHomeRealmDiscoveryPolicyID(*homeRealmDiscoveryPolicy.Id)
+	d.SetId(id.ID())
+
+	return homeRealmDiscoveryPolicyResourceRead(ctx, d, meta)
+}
+
+func homeRealmDiscoveryPolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetHomeRealmDiscoveryPolicy(ctx, *id, homerealmdiscoverypolicy.DefaultGetHomeRealmDiscoveryPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "retrieving %s", id)
+	}
+
+	homeRealmDiscoveryPolicy := resp.Model
+	if homeRealmDiscoveryPolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	flattenStsPolicy(d, *homeRealmDiscoveryPolicy)
+
+	return nil
+}
+
+func homeRealmDiscoveryPolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	policy := expandStsPolicy(d)
+	properties := stable.HomeRealmDiscoveryPolicy{
+		Definition:            policy.Definition,
+		Description:           policy.Description,
+		DisplayName:           policy.DisplayName,
+		IsOrganizationDefault: policy.IsOrganizationDefault,
+	}
+
+	if _, err := client.UpdateHomeRealmDiscoveryPolicy(ctx, *id, properties, homerealmdiscoverypolicy.DefaultUpdateHomeRealmDiscoveryPolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update %s", id)
+	}
+
+	return homeRealmDiscoveryPolicyResourceRead(ctx, d, meta)
+}
+
+func homeRealmDiscoveryPolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if resp, err := client.DeleteHomeRealmDiscoveryPolicy(ctx, *id, homerealmdiscoverypolicy.DefaultDeleteHomeRealmDiscoveryPolicyOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/homerealmdiscoverypolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type HomeRealmDiscoveryPolicyResource struct{}
+
+func TestHomeRealmDiscoveryPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_home_realm_discovery_policy", "test")
+	r := HomeRealmDiscoveryPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Managed by Terraform"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestHomeRealmDiscoveryPolicy_invalidDefinition(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_home_realm_discovery_policy", "test")
+	r := HomeRealmDiscoveryPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidDefinition(data),
+			ExpectError: regexp.MustCompile("contains an invalid JSON"),
+		},
+	})
+}
+
+func (r HomeRealmDiscoveryPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetHomeRealmDiscoveryPolicy(ctx, *id, homerealmdiscoverypolicy.DefaultGetHomeRealmDiscoveryPolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (HomeRealmDiscoveryPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  definition = [
+    "{\"HomeRealmDiscoveryPolicy\":{\"AccelerateToFederatedDomain\":true,\"PreferredDomain\":\"federated.example.com\"}}"
+  ]
+  display_name = "acctest-%[1]s"
+}
+`, data.RandomString)
+}
+
+func (HomeRealmDiscoveryPolicyResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  definition = [
+    "{\"HomeRealmDiscoveryPolicy\":{\"AccelerateToFederatedDomain\":false,\"AllowCloudPasswordValidation\":true}}"
+  ]
+  description  = "Managed by Terraform"
+  display_name = "acctest-%[1]s-updated"
+}
+`, data.RandomString)
+}
+
+func (HomeRealmDiscoveryPolicyResource) invalidDefinition(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_home_realm_discovery_policy" "test" {
+  definition = [
+    "{\"HomeRealmDiscoveryPolicy\":{\"AccelerateToFederatedDomain\":true,",
+  ]
+  display_name = "acctest-%[1]s"
+}
+`, data.RandomString)
+}
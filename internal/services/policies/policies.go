@@ -5,10 +5,19 @@ package policies
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/rolemanagementpolicyassignment"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 	"github.com/hashicorp/terraform-provider-azuread/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/policies/parse"
 )
@@ -41,3 +50,238 @@ func getPolicyId(ctx context.Context, metadata sdk.ResourceMetaData, scopeId, ro
 
 	return parse.NewRoleManagementPolicyID(assignmentId.ScopeType, assignmentId.ScopeId, assignmentId.PolicyId), nil
 }
+
+// retiredTokenLifetimeProperties are the refresh and session token lifetime settings which are no longer honored by
+// token lifetime policies. These lifetimes are now configured using Conditional Access sign-in frequency.
+var retiredTokenLifetimeProperties = []string{
+	"MaxAgeMultiFactor",
+	"MaxAgeSessionMultiFactor",
+	"MaxAgeSessionSingleFactor",
+	"MaxAgeSingleFactor",
+	"MaxInactiveTime",
+}
+
+// validateTokenLifetimePolicyDefinition checks that a token lifetime policy definition is valid JSON, and returns a
+// warning when it contains any retired properties, so that these are reported when planning
+func validateTokenLifetimePolicyDefinition(i interface{}, path cty.Path) pluginsdk.Diagnostics {
+	if diags := validation.ValidateDiag(validation.All(validation.StringIsNotEmpty, validation.StringIsJSON))(i, path); diags.HasError() {
+		return diags
+	}
+
+	var policy map[string]map[string]interface{}
+	if err := json.Unmarshal([]byte(i.(string)), &policy); err != nil {
+		return nil
+	}
+
+	found := make([]string, 0)
+	for _, properties := range policy {
+		for property := range properties {
+			if slices.Contains(retiredTokenLifetimeProperties, property) && !slices.Contains(found, property) {
+				found = append(found, property)
+			}
+		}
+	}
+
+	if len(found) == 0 {
+		return nil
+	}
+
+	slices.Sort(found)
+
+	return pluginsdk.Diagnostics{{
+		Severity: pluginsdk.DiagWarning,
+		Summary:  "Token lifetime policy definition contains retired properties",
+		Detail: fmt.Sprintf("The following properties are no longer honored for refresh and session tokens and will be ignored: %s. "+
+			"Use the Conditional Access sign-in frequency setting instead.", strings.Join(found, ", ")),
+		AttributePath: path,
+	}}
+}
+
+// stsPolicyResourceSchema returns the schema shared by the home realm discovery, token issuance and token lifetime
+// policy resources. `assignedTo` describes the objects to which the policy is applied when it is the organization
+// default, and `definitionValidateFunc` validates each element of the definition.
+func stsPolicyResourceSchema(assignedTo string, definitionValidateFunc func(interface{}, cty.Path) pluginsdk.Diagnostics) map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"definition": {
+			Description: "A string collection containing a JSON string that defines the rules and settings for this policy",
+			Type:        pluginsdk.TypeList,
+			Required:    true,
+			Elem: &pluginsdk.Schema{
+				Type:             pluginsdk.TypeString,
+				ValidateDiagFunc: definitionValidateFunc,
+			},
+		},
+
+		"display_name": {
+			Description:  "Display name for this policy",
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Description: "Description for this policy",
+			Type:        pluginsdk.TypeString,
+			Optional:    true,
+		},
+
+		"is_organization_default": {
+			Description: fmt.Sprintf("Whether this policy should be applied to all %s in the tenant which do not have a policy assigned", assignedTo),
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
+
+// stsPolicyDefinitionValidateFunc validates that each element of a policy definition is a non-empty JSON string
+var stsPolicyDefinitionValidateFunc = validation.ValidateDiag(validation.All(validation.StringIsNotEmpty, validation.StringIsJSON))
+
+// expandStsPolicy returns the properties common to all STS policies from the resource configuration. The description
+// is sent when it has changed, so that it is cleared when removed from the configuration.
+func expandStsPolicy(d *pluginsdk.ResourceData) stable.BaseStsPolicyImpl {
+	policy := stable.BaseStsPolicyImpl{
+		Definition:            tf.ExpandStringSlice(d.Get("definition").([]interface{})),
+		Description:           nullable.NoZero(d.Get("description").(string)),
+		DisplayName:           nullable.Value(d.Get("display_name").(string)),
+		IsOrganizationDefault: nullable.Value(d.Get("is_organization_default").(bool)),
+	}
+
+	if d.HasChange("description") {
+		policy.Description = nullable.Value(d.Get("description").(string))
+	}
+
+	return policy
+}
+
+// flattenStsPolicy sets the properties common to all STS policies in the resource state
+func flattenStsPolicy(d *pluginsdk.ResourceData, policy stable.StsPolicy) {
+	p := policy.StsPolicy()
+
+	tf.Set(d, "definition", tf.FlattenStringSlice(p.Definition))
+	tf.Set(d, "description", p.Description.GetOrZero())
+	tf.Set(d, "display_name", p.DisplayName.GetOrZero())
+	tf.Set(d, "is_organization_default", p.IsOrganizationDefault.GetOrZero())
+}
+
+// appManagementPolicyLifetimeRestrictionTypes are the restriction types which require a maximum lifetime
+var appManagementPolicyLifetimeRestrictionTypes = []string{
+	string(stable.AppCredentialRestrictionType_PasswordLifetime),
+	string(stable.AppCredentialRestrictionType_SymmetricKeyLifetime),
+	string(stable.AppKeyCredentialRestrictionType_AsymmetricKeyLifetime),
+}
+
+// appManagementPolicyCredentialRestrictionSchema returns the schema for a password or key credential restriction block,
+// for which `restrictionTypes` are the permitted restriction types
+func appManagementPolicyCredentialRestrictionSchema(description string, restrictionTypes []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Description: description,
+		Type:        pluginsdk.TypeList,
+		Optional:    true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"restriction_type": {
+					Description:  "The type of restriction",
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(restrictionTypes, false),
+				},
+
+				"max_lifetime": {
+					Description:  "The maximum lifetime of a credential, as an ISO 8601 duration. Required for lifetime restrictions",
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.ISO8601Duration,
+				},
+
+				"restrict_for_apps_created_after": {
+					Description:  "The restriction only applies to applications and service principals created after this date, formatted as an RFC3339 date string",
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+			},
+		},
+	}
+}
+
+// validateAppManagementPolicyCredentialRestriction checks that a maximum lifetime is specified for lifetime
+// restrictions, and is not specified for any other restriction type
+func validateAppManagementPolicyCredentialRestriction(restrictionType, maxLifetime string) error {
+	if slices.Contains(appManagementPolicyLifetimeRestrictionTypes, restrictionType) {
+		if maxLifetime == "" {
+			return fmt.Errorf("`max_lifetime` must be specified for the %q restriction type", restrictionType)
+		}
+	} else if maxLifetime != "" {
+		return fmt.Errorf("`max_lifetime` can only be specified for the [%s] restriction types", strings.Join(appManagementPolicyLifetimeRestrictionTypes, ", "))
+	}
+
+	return nil
+}
+
+func expandAppManagementPolicyRestrictions(passwordCredentials, keyCredentials []interface{}) *stable.CustomAppManagementConfiguration {
+	passwordConfigurations := make([]stable.PasswordCredentialConfiguration, 0)
+	for _, raw := range passwordCredentials {
+		if raw == nil {
+			continue
+		}
+		restriction := raw.(map[string]interface{})
+		passwordConfigurations = append(passwordConfigurations, stable.PasswordCredentialConfiguration{
+			MaxLifetime:                         nullable.NoZero(restriction["max_lifetime"].(string)),
+			RestrictForAppsCreatedAfterDateTime: nullable.NoZero(restriction["restrict_for_apps_created_after"].(string)),
+			RestrictionType:                     pointer.To(stable.AppCredentialRestrictionType(restriction["restriction_type"].(string))),
+		})
+	}
+
+	keyConfigurations := make([]stable.KeyCredentialConfiguration, 0)
+	for _, raw := range keyCredentials {
+		if raw == nil {
+			continue
+		}
+		restriction := raw.(map[string]interface{})
+		keyConfigurations = append(keyConfigurations, stable.KeyCredentialConfiguration{
+			MaxLifetime:                         nullable.NoZero(restriction["max_lifetime"].(string)),
+			RestrictForAppsCreatedAfterDateTime: nullable.NoZero(restriction["restrict_for_apps_created_after"].(string)),
+			RestrictionType:                     pointer.To(stable.AppKeyCredentialRestrictionType(restriction["restriction_type"].(string))),
+		})
+	}
+
+	return &stable.CustomAppManagementConfiguration{
+		KeyCredentials:      &keyConfigurations,
+		PasswordCredentials: &passwordConfigurations,
+	}
+}
+
+func flattenAppManagementPolicyPasswordCredentials(in *stable.CustomAppManagementConfiguration) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, credential := range pointer.From(in.PasswordCredentials) {
+		result = append(result, map[string]interface{}{
+			"restriction_type":                string(pointer.From(credential.RestrictionType)),
+			"max_lifetime":                    credential.MaxLifetime.GetOrZero(),
+			"restrict_for_apps_created_after": credential.RestrictForAppsCreatedAfterDateTime.GetOrZero(),
+		})
+	}
+
+	return result
+}
+
+func flattenAppManagementPolicyKeyCredentials(in *stable.CustomAppManagementConfiguration) []interface{} {
+	result := make([]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, credential := range pointer.From(in.KeyCredentials) {
+		result = append(result, map[string]interface{}{
+			"restriction_type":                string(pointer.From(credential.RestrictionType)),
+			"max_lifetime":                    credential.MaxLifetime.GetOrZero(),
+			"restrict_for_apps_created_after": credential.RestrictForAppsCreatedAfterDateTime.GetOrZero(),
+		})
+	}
+
+	return result
+}
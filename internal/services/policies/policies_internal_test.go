@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func TestValidateTokenLifetimePolicyDefinition(t *testing.T) {
+	cases := []struct {
+		TestName   string
+		Definition interface{}
+		Error      bool
+		Expected   []string
+	}{
+		{
+			TestName:   "AccessTokenOnly",
+			Definition: `{"TokenLifetimePolicy":{"Version":1,"AccessTokenLifetime":"02:00:00"}}`,
+		},
+		{
+			TestName:   "Empty",
+			Definition: "",
+			Error:      true,
+		},
+		{
+			TestName:   "InvalidJson",
+			Definition: `{"TokenLifetimePolicy":`,
+			Error:      true,
+		},
+		{
+			TestName:   "RetiredProperties",
+			Definition: `{"TokenLifetimePolicy":{"Version":1,"MaxInactiveTime":"1.00:00:00","MaxAgeSingleFactor":"until-revoked"}}`,
+			Expected:   []string{"MaxAgeSingleFactor", "MaxInactiveTime"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			path := cty.GetAttrPath("definition").IndexInt(0)
+			diags := validateTokenLifetimePolicyDefinition(tc.Definition, path)
+
+			if tc.Error {
+				if !diags.HasError() {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("expected no error, got %+v", diags)
+			}
+
+			if len(tc.Expected) == 0 {
+				if len(diags) > 0 {
+					t.Fatalf("expected no warnings, got %d", len(diags))
+				}
+				return
+			}
+
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 warning, got %d", len(diags))
+			}
+			if diags[0].Severity != pluginsdk.DiagWarning {
+				t.Fatalf("expected a warning, got severity %v", diags[0].Severity)
+			}
+			if !diags[0].AttributePath.Equals(path) {
+				t.Fatalf("expected warning for path %#v, got %#v", path, diags[0].AttributePath)
+			}
+			if !strings.Contains(diags[0].Detail, strings.Join(tc.Expected, ", ")+".") {
+				t.Fatalf("expected warning to list %v, got %q", tc.Expected, diags[0].Detail)
+			}
+		})
+	}
+}
+
+func TestValidateAppManagementPolicyCredentialRestriction(t *testing.T) {
+	cases := []struct {
+		TestName        string
+		RestrictionType string
+		MaxLifetime     string
+		Error           string
+	}{
+		{
+			TestName:        "PasswordLifetime",
+			RestrictionType: "passwordLifetime",
+			MaxLifetime:     "P90D",
+		},
+		{
+			TestName:        "AsymmetricKeyLifetime",
+			RestrictionType: "asymmetricKeyLifetime",
+			MaxLifetime:     "P1Y",
+		},
+		{
+			TestName:        "Addition",
+			RestrictionType: "customPasswordAddition",
+		},
+		{
+			TestName:        "MissingMaxLifetime",
+			RestrictionType: "asymmetricKeyLifetime",
+			Error:           "`max_lifetime` must be specified",
+		},
+		{
+			TestName:        "UnexpectedMaxLifetime",
+			RestrictionType: "passwordAddition",
+			MaxLifetime:     "P90D",
+			Error:           "`max_lifetime` can only be specified",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := validateAppManagementPolicyCredentialRestriction(tc.RestrictionType, tc.MaxLifetime)
+			if tc.Error == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error containing %q, got none", tc.Error)
+			}
+			if !strings.Contains(err.Error(), tc.Error) {
+				t.Fatalf("expected an error containing %q, got: %v", tc.Error, err)
+			}
+		})
+	}
+}
+
+func TestAppManagementPolicyRestrictionsRoundTrip(t *testing.T) {
+	passwordCredentials := []interface{}{
+		map[string]interface{}{
+			"restriction_type":                "passwordLifetime",
+			"max_lifetime":                    "P90D",
+			"restrict_for_apps_created_after": "",
+		},
+	}
+	keyCredentials := []interface{}{
+		map[string]interface{}{
+			"restriction_type":                "asymmetricKeyLifetime",
+			"max_lifetime":                    "P1Y",
+			"restrict_for_apps_created_after": "2020-01-01T00:00:00Z",
+		},
+	}
+
+	restrictions := expandAppManagementPolicyRestrictions(passwordCredentials, keyCredentials)
+
+	if out := flattenAppManagementPolicyPasswordCredentials(restrictions); !reflect.DeepEqual(out, passwordCredentials) {
+		t.Fatalf("expected password credentials %v, got %v", passwordCredentials, out)
+	}
+	if out := flattenAppManagementPolicyKeyCredentials(restrictions); !reflect.DeepEqual(out, keyCredentials) {
+		t.Fatalf("expected key credentials %v, got %v", keyCredentials, out)
+	}
+}
+
+func TestFlattenAppManagementPolicyRestrictionsEmpty(t *testing.T) {
+	if out := flattenAppManagementPolicyPasswordCredentials(nil); len(out) != 0 {
+		t.Fatalf("expected no password credentials, got %v", out)
+	}
+	if out := flattenAppManagementPolicyKeyCredentials(&stable.CustomAppManagementConfiguration{}); len(out) != 0 {
+		t.Fatalf("expected no key credentials, got %v", out)
+	}
+}
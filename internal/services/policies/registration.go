@@ -35,8 +35,12 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
+		"azuread_app_management_policy":          appManagementPolicyResource(),
 		"azuread_authentication_strength_policy": authenticationStrengthPolicyResource(),
 		"azuread_claims_mapping_policy":          claimsMappingPolicyResource(),
+		"azuread_home_realm_discovery_policy":    homeRealmDiscoveryPolicyResource(),
+		"azuread_token_issuance_policy":          tokenIssuancePolicyResource(),
+		"azuread_token_lifetime_policy":          tokenLifetimePolicyResource(),
 	}
 }
 
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/tokenissuancepolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func tokenIssuancePolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: tokenIssuancePolicyResourceCreate,
+		ReadContext:   tokenIssuancePolicyResourceRead,
+		UpdateContext: tokenIssuancePolicyResourceUpdate,
+		DeleteContext: tokenIssuancePolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidatePolicyTokenIssuancePolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: stsPolicyResourceSchema("applications", stsPolicyDefinitionValidateFunc),
+	}
+}
+
+func tokenIssuancePolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TokenIssuancePolicyClient
+
+	policy := expandStsPolicy(d)
+	properties := stable.TokenIssuancePolicy{
+		Definition:            policy.Definition,
+		Description:           policy.Description,
+		DisplayName:           policy.DisplayName,
+		IsOrganizationDefault: policy.IsOrganizationDefault,
+	}
+
+	resp, err := client.CreateTokenIssuancePolicy(ctx, properties, tokenissuancepolicy.DefaultCreateTokenIssuancePolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create Token Issuance Policy")
+	}
+
+	tokenIssuancePolicy := resp.Model
+	if tokenIssuancePolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not create Token Issuance Policy")
+	}
+	if tokenIssuancePolicy.Id == nil {
+		return tf.ErrorDiagF(errors.New("model return with nil ID"), "Could not create Token Issuance Policy")
+	}
+
+	id := stable.NewPolicyTokenIssuancePolicyID(*tokenIssuancePolicy.Id)
+	d.SetId(id.ID())
+
+	return tokenIssuancePolicyResourceRead(ctx, d, meta)
+}
+
+func tokenIssuancePolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TokenIssuancePolicyClient
+
+	id, err := stable.ParsePolicyTokenIssuancePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetTokenIssuancePolicy(ctx, *id, tokenissuancepolicy.DefaultGetTokenIssuancePolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "retrieving %s", id)
+	}
+
+	tokenIssuancePolicy := resp.Model
+	if tokenIssuancePolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	flattenStsPolicy(d, *tokenIssuancePolicy)
+
+	return nil
+}
+
+func tokenIssuancePolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TokenIssuancePolicyClient
+
+	id, err := stable.ParsePolicyTokenIssuancePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	policy := expandStsPolicy(d)
+	properties := stable.TokenIssuancePolicy{
+		Definition:            policy.Definition,
+		Description:           policy.Description,
+		DisplayName:           policy.DisplayName,
+		IsOrganizationDefault: policy.IsOrganizationDefault,
+	}
+
+	if _, err := client.UpdateTokenIssuancePolicy(ctx, *id, properties, tokenissuancepolicy.DefaultUpdateTokenIssuancePolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update %s", id)
+	}
+
+	return tokenIssuancePolicyResourceRead(ctx, d, meta)
+}
+
+func tokenIssuancePolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TokenIssuancePolicyClient
+
+	id, err := stable.ParsePolicyTokenIssuancePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if resp, err := client.DeleteTokenIssuancePolicy(ctx, *id, tokenissuancepolicy.DefaultDeleteTokenIssuancePolicyOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	return nil
+}
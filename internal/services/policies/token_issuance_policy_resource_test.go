@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/tokenissuancepolicy"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type TokenIssuancePolicyResource struct{}
+
+func TestTokenIssuancePolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_token_issuance_policy", "test")
+	r := TokenIssuancePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue("Managed by Terraform"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("description").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestTokenIssuancePolicy_invalidDefinition(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_token_issuance_policy", "test")
+	r := TokenIssuancePolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidDefinition(data),
+			ExpectError: regexp.MustCompile("contains an invalid JSON"),
+		},
+	})
+}
+
+func (r TokenIssuancePolicyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Policies.TokenIssuancePolicyClient
+
+	id, err := stable.ParsePolicyTokenIssuancePolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetTokenIssuancePolicy(ctx, *id, tokenissuancepolicy.DefaultGetTokenIssuancePolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (TokenIssuancePolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_token_issuance_policy" "test" {
+  definition = [
+    "{\"TokenIssuancePolicy\":{\"Version\":1,\"SigningAlgorithm\":\"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256\",\"SamlTokenVersion\":\"2.0\",\"TokenResponseSigningPolicy\":\"TokenOnly\"}}"
+  ]
+  display_name = "acctest-%[1]s"
+}
+`, data.RandomString)
+}
+
+func (TokenIssuancePolicyResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_token_issuance_policy" "test" {
+  definition = [
+    "{\"TokenIssuancePolicy\":{\"Version\":1,\"SigningAlgorithm\":\"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256\",\"SamlTokenVersion\":\"2.0\",\"TokenResponseSigningPolicy\":\"ResponseAndToken\"}}"
+  ]
+  description  = "Managed by Terraform"
+  display_name = "acctest-%[1]s-updated"
+}
+`, data.RandomString)
+}
+
+func (TokenIssuancePolicyResource) invalidDefinition(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_token_issuance_policy" "test" {
+  definition = [
+    "{\"TokenIssuancePolicy\":{\"Version\":1,",
+  ]
+  display_name = "acctest-%[1]s"
+}
+`, data.RandomString)
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/policies/stable/tokenlifetimepolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func tokenLifetimePolicyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: tokenLifetimePolicyResourceCreate,
+		ReadContext:   tokenLifetimePolicyResourceRead,
+		UpdateContext: tokenLifetimePolicyResourceUpdate,
+		DeleteContext: tokenLifetimePolicyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidatePolicyTokenLifetimePolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: stsPolicyResourceSchema("applications", validateTokenLifetimePolicyDefinition),
+	}
+}
+
+func tokenLifetimePolicyResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TokenLifetimePolicyClient
+
+	policy := expandStsPolicy(d)
+	properties := stable.TokenLifetimePolicy{
+		Definition:            policy.Definition,
+		Description:           policy.Description,
+		DisplayName:           policy.DisplayName,
+		IsOrganizationDefault: policy.IsOrganizationDefault,
+	}
+
+	resp, err := client.CreateTokenLifetimePolicy(ctx, properties, tokenlifetimepolicy.DefaultCreateTokenLifetimePolicyOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Could not create Token Lifetime Policy")
+	}
+
+	tokenLifetimePolicy := resp.Model
+	if tokenLifetimePolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Could not create Token Lifetime Policy")
+	}
+	if tokenLifetimePolicy.Id == nil {
+		return tf.ErrorDiagF(errors.New("model return with nil ID"), "Could not create Token Lifetime Policy")
+	}
+
+	id := stable.NewPolicyTokenLifetimePolicyID(*tokenLifetimePolicy.Id)
+	d.SetId(id.ID())
+
+	return tokenLifetimePolicyResourceRead(ctx, d, meta)
+}
+
+func tokenLifetimePolicyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TokenLifetimePolicyClient
+
+	id, err := stable.ParsePolicyTokenLifetimePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	resp, err := client.GetTokenLifetimePolicy(ctx, *id, tokenlifetimepolicy.DefaultGetTokenLifetimePolicyOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+
+		return tf.ErrorDiagF(err, "retrieving %s", id)
+	}
+
+	tokenLifetimePolicy := resp.Model
+	if tokenLifetimePolicy == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	flattenStsPolicy(d, *tokenLifetimePolicy)
+
+	return nil
+}
+
+func tokenLifetimePolicyResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TokenLifetimePolicyClient
+
+	id, err := stable.ParsePolicyTokenLifetimePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	policy := expandStsPolicy(d)
+	properties := stable.TokenLifetimePolicy{
+		Definition:            policy.Definition,
+		Description:           policy.Description,
+		DisplayName:           policy.DisplayName,
+		IsOrganizationDefault: policy.IsOrganizationDefault,
+	}
+
+	if _, err := client.UpdateTokenLifetimePolicy(ctx, *id, properties, tokenlifetimepolicy.DefaultUpdateTokenLifetimePolicyOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Could not update %s", id)
+	}
+
+	return tokenLifetimePolicyResourceRead(ctx, d, meta)
+}
+
+func tokenLifetimePolicyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Policies.TokenLifetimePolicyClient
+
+	id, err := stable.ParsePolicyTokenLifetimePolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
+	}
+
+	if resp, err := client.DeleteTokenLifetimePolicy(ctx, *id, tokenlifetimepolicy.DefaultDeleteTokenLifetimePolicyOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	return nil
+}
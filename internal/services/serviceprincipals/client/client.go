@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/oauth2permissiongrants/stable/oauth2permissiongrant"
 	serviceprincipalBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/beta/serviceprincipal"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/claimsmappingpolicy"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/federatedidentitycredential"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/homerealmdiscoverypolicy"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/owner"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
@@ -15,13 +17,15 @@ import (
 )
 
 type Client struct {
-	ClaimsMappingPolicyClient   *claimsmappingpolicy.ClaimsMappingPolicyClient
-	DirectoryObjectClient       *directoryobject.DirectoryObjectClient
-	OAuth2PermissionGrantClient *oauth2permissiongrant.OAuth2PermissionGrantClient
-	ServicePrincipalClient      *serviceprincipal.ServicePrincipalClient
-	ServicePrincipalClientBeta  *serviceprincipalBeta.ServicePrincipalClient
-	ServicePrincipalOwnerClient *owner.OwnerClient
-	SynchronizationJobClient    *synchronizationjob.SynchronizationJobClient
+	ClaimsMappingPolicyClient         *claimsmappingpolicy.ClaimsMappingPolicyClient
+	DirectoryObjectClient             *directoryobject.DirectoryObjectClient
+	FederatedIdentityCredentialClient *federatedidentitycredential.FederatedIdentityCredentialClient
+	HomeRealmDiscoveryPolicyClient    *homerealmdiscoverypolicy.HomeRealmDiscoveryPolicyClient
+	OAuth2PermissionGrantClient       *oauth2permissiongrant.OAuth2PermissionGrantClient
+	ServicePrincipalClient            *serviceprincipal.ServicePrincipalClient
+	ServicePrincipalClientBeta        *serviceprincipalBeta.ServicePrincipalClient
+	ServicePrincipalOwnerClient       *owner.OwnerClient
+	SynchronizationJobClient          *synchronizationjob.SynchronizationJobClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -37,6 +41,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(directoryObjectClient.Client)
 
+	federatedIdentityCredentialClient, err := federatedidentitycredential.NewFederatedIdentityCredentialClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(federatedIdentityCredentialClient.Client)
+
+	homeRealmDiscoveryPolicyClient, err := homerealmdiscoverypolicy.NewHomeRealmDiscoveryPolicyClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(homeRealmDiscoveryPolicyClient.Client)
+
 	oAuth2PermissionGrantClient, err := oauth2permissiongrant.NewOAuth2PermissionGrantClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -69,12 +85,14 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(synchronizationJobClient.Client)
 
 	return &Client{
-		ClaimsMappingPolicyClient:   claimsMappingPolicyClient,
-		DirectoryObjectClient:       directoryObjectClient,
-		OAuth2PermissionGrantClient: oAuth2PermissionGrantClient,
-		ServicePrincipalClient:      servicePrincipalClient,
-		ServicePrincipalClientBeta:  servicePrincipalClientBeta,
-		ServicePrincipalOwnerClient: servicePrincipalOwnerClient,
-		SynchronizationJobClient:    synchronizationJobClient,
+		ClaimsMappingPolicyClient:         claimsMappingPolicyClient,
+		DirectoryObjectClient:             directoryObjectClient,
+		FederatedIdentityCredentialClient: federatedIdentityCredentialClient,
+		HomeRealmDiscoveryPolicyClient:    homeRealmDiscoveryPolicyClient,
+		OAuth2PermissionGrantClient:       oAuth2PermissionGrantClient,
+		ServicePrincipalClient:            servicePrincipalClient,
+		ServicePrincipalClientBeta:        servicePrincipalClientBeta,
+		ServicePrincipalOwnerClient:       servicePrincipalOwnerClient,
+		SynchronizationJobClient:          synchronizationJobClient,
 	}, nil
 }
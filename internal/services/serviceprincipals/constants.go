@@ -16,6 +16,7 @@ const (
 var possibleValuesForKeyCredentialType = []string{KeyCredentialTypeAsymmetricX509Cert, KeyCredentialTypeX509CertAndPassword}
 
 const (
+	PreferredSingleSignOnModeLinked       = "linked"
 	PreferredSingleSignOnModeNone         = ""
 	PreferredSingleSignOnModeNotSupported = "notSupported"
 	PreferredSingleSignOnModeOidc         = "oidc"
@@ -24,6 +25,7 @@ const (
 )
 
 var possibleValuesForPreferredSingleSignOnMode = []string{
+	PreferredSingleSignOnModeLinked,
 	PreferredSingleSignOnModeNone,
 	PreferredSingleSignOnModeNotSupported,
 	PreferredSingleSignOnModeOidc,
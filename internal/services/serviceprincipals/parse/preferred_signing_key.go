@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type PreferredSigningKeyId struct {
+	ServicePrincipalId string
+}
+
+func NewPreferredSigningKeyID(servicePrincipalId string) *PreferredSigningKeyId {
+	return &PreferredSigningKeyId{
+		ServicePrincipalId: servicePrincipalId,
+	}
+}
+
+// ParsePreferredSigningKeyID parses 'input' into a PreferredSigningKeyId
+func ParsePreferredSigningKeyID(input string) (*PreferredSigningKeyId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&PreferredSigningKeyId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := &PreferredSigningKeyId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// ValidatePreferredSigningKeyID checks that 'input' can be parsed as a Preferred Signing Key ID
+func ValidatePreferredSigningKeyID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParsePreferredSigningKeyID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return validation.IsUUID(id.ServicePrincipalId, "ID")
+}
+
+func (id *PreferredSigningKeyId) ID() string {
+	fmtString := "/servicePrincipals/%s/preferredTokenSigningKey"
+	return fmt.Sprintf(fmtString, id.ServicePrincipalId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *PreferredSigningKeyId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("servicePrincipals", "servicePrincipals", "servicePrincipals"),
+		resourceids.UserSpecifiedSegment("servicePrincipalId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("preferredTokenSigningKey", "preferredTokenSigningKey", "preferredTokenSigningKey"),
+	}
+}
+
+func (id *PreferredSigningKeyId) String() string {
+	return fmt.Sprintf("Preferred Token Signing Key (Service Principal ID: %q)", id.ServicePrincipalId)
+}
+
+func (id *PreferredSigningKeyId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.ServicePrincipalId, ok = input.Parsed["servicePrincipalId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "servicePrincipalId", input)
+	}
+
+	return nil
+}
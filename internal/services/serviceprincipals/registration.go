@@ -30,20 +30,27 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_service_principal":  servicePrincipalData(),
-		"azuread_service_principals": servicePrincipalsDataSource(),
+		"azuread_service_principal":                             servicePrincipalData(),
+		"azuread_service_principal_certificate":                 servicePrincipalCertificateDataSource(),
+		"azuread_service_principal_delegated_permission_grants": servicePrincipalDelegatedPermissionGrantsDataSource(),
+		"azuread_service_principals":                            servicePrincipalsDataSource(),
+		"azuread_well_known_service_principal":                  wellKnownServicePrincipalDataSource(),
 	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_service_principal":                                  servicePrincipalResource(),
-		"azuread_service_principal_certificate":                      servicePrincipalCertificateResource(),
-		"azuread_service_principal_claims_mapping_policy_assignment": servicePrincipalClaimsMappingPolicyAssignmentResource(),
-		"azuread_service_principal_delegated_permission_grant":       servicePrincipalDelegatedPermissionGrantResource(),
-		"azuread_service_principal_password":                         servicePrincipalPasswordResource(),
-		"azuread_service_principal_token_signing_certificate":        servicePrincipalTokenSigningCertificateResource(),
+		"azuread_service_principal":                                        servicePrincipalResource(),
+		"azuread_service_principal_certificate":                            servicePrincipalCertificateResource(),
+		"azuread_service_principal_certificates":                           servicePrincipalCertificatesResource(),
+		"azuread_service_principal_claims_mapping_policy_assignment":       servicePrincipalClaimsMappingPolicyAssignmentResource(),
+		"azuread_service_principal_delegated_permission_grant":             servicePrincipalDelegatedPermissionGrantResource(),
+		"azuread_service_principal_federated_identity_credential":          servicePrincipalFederatedIdentityCredentialResource(),
+		"azuread_service_principal_home_realm_discovery_policy_assignment": servicePrincipalHomeRealmDiscoveryPolicyAssignmentResource(),
+		"azuread_service_principal_password":                               servicePrincipalPasswordResource(),
+		"azuread_service_principal_preferred_signing_key":                  servicePrincipalPreferredSigningKeyResource(),
+		"azuread_service_principal_token_signing_certificate":              servicePrincipalTokenSigningCertificateResource(),
 	}
 }
 
@@ -56,5 +63,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 // Resources returns the typed Resources supported by this service
 func (r Registration) Resources() []sdk.Resource {
-	return []sdk.Resource{}
+	return []sdk.Resource{
+		ServicePrincipalOwnerResource{},
+	}
 }
@@ -3,7 +3,12 @@
 
 package serviceprincipals
 
-import "github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
 
 func schemaAppRolesComputed() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
@@ -113,3 +118,66 @@ func schemaOauth2PermissionScopesComputed() *pluginsdk.Schema {
 		},
 	}
 }
+
+// servicePrincipalCertificateHash identifies a certificate block by its type, encoding and value, so that computed
+// properties such as `key_id` remain associated with the same certificate when blocks are reordered
+func servicePrincipalCertificateHash(v interface{}) int {
+	certificate := v.(map[string]interface{})
+	return pluginsdk.HashString(fmt.Sprintf("%s-%s-%s", certificate["type"], certificate["encoding"], certificate["value"]))
+}
+
+func schemaServicePrincipalCertificate() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"key_id": {
+				Description:  "A UUID used to uniquely identify this certificate. If not specified a UUID will be automatically generated",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"type": {
+				Description:  "The type of key/certificate",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(possibleValuesForKeyCredentialType, false),
+			},
+
+			"encoding": {
+				Description: "Specifies the encoding used for the supplied certificate data",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+				Default:     "pem",
+				ValidateFunc: validation.StringInSlice([]string{
+					"base64",
+					"hex",
+					"pem",
+				}, false),
+			},
+
+			"value": {
+				Description: "The certificate data, which can be PEM encoded, base64 encoded DER or hexadecimal encoded DER",
+				Type:        pluginsdk.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+
+			"start_date": {
+				Description:  "The start date from which the certificate is valid, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`). If this isn't specified, the current date is used",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+
+			"end_date": {
+				Description:  "The end date until which the certificate is valid, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`)",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+		},
+	}
+}
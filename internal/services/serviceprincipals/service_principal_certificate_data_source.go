@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func servicePrincipalCertificateDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: servicePrincipalCertificateDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The object ID of the service principal for which to retrieve certificates",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"key_id": {
+				Description:  "The key ID of a specific certificate to retrieve. If omitted, all certificates for the service principal are returned",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"certificates": {
+				Description: "A list of certificates associated with the service principal",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_id": {
+							Description: "A UUID used to uniquely identify this certificate",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"type": {
+							Description: "The type of key/certificate",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"usage": {
+							Description: "The purpose for which the certificate can be used",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"start_date": {
+							Description: "The start date from which the certificate is valid",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"end_date": {
+							Description: "The end date until which the certificate is valid",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"thumbprint": {
+							Description: "The SHA-1 thumbprint of the certificate, as an uppercase hexadecimal string",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func servicePrincipalCertificateDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	resp, err := client.GetServicePrincipal(ctx, *servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "service_principal_id", "%s was not found", servicePrincipalId)
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving %s", servicePrincipalId)
+	}
+
+	servicePrincipal := resp.Model
+	if servicePrincipal == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	keyCredentials := make([]stable.KeyCredential, 0)
+	keyId := d.Get("key_id").(string)
+	if keyId != "" {
+		credential := credentials.GetKeyCredential(servicePrincipal.KeyCredentials, keyId)
+		if credential == nil {
+			return tf.ErrorDiagPathF(nil, "key_id", "Certificate credential %q was not found for %s", keyId, servicePrincipalId)
+		}
+		keyCredentials = append(keyCredentials, *credential)
+	} else if servicePrincipal.KeyCredentials != nil {
+		keyCredentials = *servicePrincipal.KeyCredentials
+	}
+
+	keyIds := make([]string, 0)
+	certificates := make([]map[string]interface{}, 0)
+	for _, cred := range keyCredentials {
+		keyIds = append(keyIds, cred.KeyId.GetOrZero())
+		certificates = append(certificates, map[string]interface{}{
+			"key_id":     cred.KeyId.GetOrZero(),
+			"type":       cred.Type.GetOrZero(),
+			"usage":      cred.Usage.GetOrZero(),
+			"start_date": cred.StartDateTime.GetOrZero(),
+			"end_date":   cred.EndDateTime.GetOrZero(),
+			"thumbprint": credentials.GetKeyCredentialThumbprint(cred),
+		})
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(servicePrincipalId.ServicePrincipalId + "/" + strings.Join(keyIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for key IDs")
+	}
+
+	d.SetId("certificates#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "certificates", certificates)
+
+	return nil
+}
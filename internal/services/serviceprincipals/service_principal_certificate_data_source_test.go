@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ServicePrincipalCertificateDataSource struct{}
+
+func TestAccServicePrincipalCertificateDataSource_all(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ServicePrincipalCertificateDataSource{}.all(data, endDate),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("certificates.#").HasValue("1"),
+			check.That(data.ResourceName).Key("certificates.0.key_id").Exists(),
+			check.That(data.ResourceName).Key("certificates.0.type").HasValue("AsymmetricX509Cert"),
+			check.That(data.ResourceName).Key("certificates.0.usage").HasValue("Verify"),
+			check.That(data.ResourceName).Key("certificates.0.start_date").Exists(),
+			check.That(data.ResourceName).Key("certificates.0.end_date").Exists(),
+			check.That(data.ResourceName).Key("certificates.0.thumbprint").Exists(),
+		),
+	}})
+}
+
+func TestAccServicePrincipalCertificateDataSource_byKeyId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ServicePrincipalCertificateDataSource{}.byKeyId(data, endDate),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("certificates.#").HasValue("1"),
+			check.That(data.ResourceName).Key("certificates.0.key_id").HasValue(data.RandomID),
+			check.That(data.ResourceName).Key("certificates.0.thumbprint").Exists(),
+		),
+	}})
+}
+
+func (ServicePrincipalCertificateDataSource) all(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal_certificate" "test" {
+  service_principal_id = azuread_service_principal_certificate.test.service_principal_id
+}
+`, ServicePrincipalCertificateResource{}.basic(data, endDate))
+}
+
+func (ServicePrincipalCertificateDataSource) byKeyId(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal_certificate" "test" {
+  service_principal_id = azuread_service_principal_certificate.test.service_principal_id
+  key_id               = azuread_service_principal_certificate.test.key_id
+}
+`, ServicePrincipalCertificateResource{}.complete(data, time.Now().UTC().Format(time.RFC3339), endDate))
+}
@@ -6,6 +6,7 @@ package serviceprincipals
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
@@ -27,18 +29,22 @@ func servicePrincipalCertificateResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		CreateContext: servicePrincipalCertificateResourceCreate,
 		ReadContext:   servicePrincipalCertificateResourceRead,
+		UpdateContext: servicePrincipalCertificateResourceUpdate,
 		DeleteContext: servicePrincipalCertificateResourceDelete,
 
+		CustomizeDiff: servicePrincipalCertificateResourceCustomizeDiff,
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
 			_, err := parse.CertificateID(id)
 			return err
-		}),
+		}, servicePrincipalCertificateResourceImport),
 
 		Schema: map[string]*pluginsdk.Schema{
 			"service_principal_id": {
@@ -50,7 +56,7 @@ func servicePrincipalCertificateResource() *pluginsdk.Resource {
 			},
 
 			"key_id": {
-				Description:  "A UUID used to uniquely identify this certificate. If not specified a UUID will be automatically generated",
+				Description:  "A UUID used to uniquely identify this certificate. If not specified a UUID will be automatically generated. Cannot be specified when `use_add_key` is true",
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Computed:     true,
@@ -58,6 +64,17 @@ func servicePrincipalCertificateResource() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 
+			// Changing the display name forces a new resource, since credentials added using the addKey action cannot
+			// be updated in place
+			"display_name": {
+				Description:  "A friendly name for the certificate, shown in the Azure portal. If not specified, this is determined by Azure Active Directory from the certificate subject",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"encoding": {
 				Description: "Specifies the encoding used for the supplied certificate data",
 				Type:        pluginsdk.TypeString,
@@ -68,16 +85,44 @@ func servicePrincipalCertificateResource() *pluginsdk.Resource {
 					"base64",
 					"hex",
 					"pem",
+					"pfx",
 				}, false),
 			},
 
-			"start_date": {
-				Description:  "The start date from which the certificate is valid, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`). If this isn't specified, the current date is used",
+			"expiry_warning_days": {
+				Description:  "The number of days before the end date of the certificate from which a warning is shown when refreshing the resource. Set to `0` to disable the warning",
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      credentials.DefaultExpiryWarningDays,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"password": {
+				Description:  "The password used to decrypt the certificate bundle, when `encoding` is `pfx`",
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.IsRFC3339Time,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"start_date": {
+				Description:   "The start date from which the certificate is valid, formatted as an RFC3339 date string (e.g. `2018-01-01T01:02:03Z`). If this isn't specified, the current date is used",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"start_date_relative"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+
+			"start_date_relative": {
+				Description:   "A relative duration from the time of creation from which the certificate is valid, for example `-5m` to allow for clock skew. Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\"",
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"start_date"},
+				ValidateFunc:  validation.StringIsNotEmpty,
 			},
 
 			"end_date": {
@@ -100,6 +145,50 @@ func servicePrincipalCertificateResource() *pluginsdk.Resource {
 				Deprecated:    "The `end_date_relative` property is deprecated and will be removed in a future version of the AzureAD provider. Please instead use the Terraform `timeadd()` function to calculate a value for the `end_date` property.",
 			},
 
+			"maximum_validity": {
+				Description:  "The maximum permitted validity period of the certificate, for example `17520h` (2 years). An error is raised when planning if the validity period resolved from the start and end dates exceeds this duration",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsPositiveDuration,
+			},
+
+			"rotate_when_changed": {
+				Description: "Arbitrary map of values that, when changed, will trigger rotation of the certificate",
+				Type:        pluginsdk.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"use_add_key": {
+				Description: "Whether to add and remove the certificate using the additive `addKey` and `removeKey` actions, instead of replacing the full set of key credentials for the service principal",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+
+			"proof_certificate": {
+				Description:  "A PEM encoded certificate already present on the service principal, used to sign the proof of possession token required by the `addKey` and `removeKey` actions. Required when `use_add_key` is true",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"proof_private_key"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"proof_private_key": {
+				Description:  "The PEM encoded RSA private key for the `proof_certificate`",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"proof_certificate"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			"type": {
 				Description:  "The type of key/certificate",
 				Type:         pluginsdk.TypeString,
@@ -108,8 +197,20 @@ func servicePrincipalCertificateResource() *pluginsdk.Resource {
 				ValidateFunc: validation.StringInSlice(possibleValuesForKeyCredentialType, false),
 			},
 
+			// Signing credentials require the private key and a matching password credential, which this resource does
+			// not support, so only `Verify` can be specified. Token signing certificates should be managed using the
+			// `azuread_service_principal_token_signing_certificate` resource.
+			"usage": {
+				Description:  "The usage of the certificate. Only `Verify` is supported",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      credentials.KeyCredentialUsageVerify,
+				ValidateFunc: validation.StringInSlice([]string{credentials.KeyCredentialUsageVerify}, false),
+			},
+
 			"value": {
-				Description: "The certificate data, which can be PEM encoded, base64 encoded DER or hexadecimal encoded DER",
+				Description: "The certificate data, which can be PEM encoded, base64 encoded DER, hexadecimal encoded DER or a base64 encoded PKCS#12 bundle",
 				Type:        pluginsdk.TypeString,
 				Required:    true,
 				ForceNew:    true,
@@ -119,6 +220,45 @@ func servicePrincipalCertificateResource() *pluginsdk.Resource {
 	}
 }
 
+// servicePrincipalCertificateResourceCustomizeDiff validates the credential validity period, and ensures that a proof
+// certificate is configured if and only if the addKey and removeKey actions are used, since both actions require a
+// proof of possession. A key ID cannot be configured together with the addKey action, which assigns its own key ID.
+func servicePrincipalCertificateResourceCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if err := credentials.ValidityCustomizeDiff(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	config := diff.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	values := config.AsValueMap()
+	useAddKey, ok := values["use_add_key"]
+	if !ok || !useAddKey.IsKnown() {
+		return nil
+	}
+	enabled := !useAddKey.IsNull() && useAddKey.True()
+
+	// The key ID is assigned by the API when using the addKey action, so cannot be specified
+	if keyId, ok := values["key_id"]; enabled && ok && !keyId.IsNull() {
+		return fmt.Errorf("`key_id` cannot be specified when `use_add_key` is true, since the key ID is assigned by the API")
+	}
+
+	for _, field := range []string{"proof_certificate", "proof_private_key"} {
+		v, ok := values[field]
+		configured := ok && !v.IsNull()
+		if enabled && !configured {
+			return fmt.Errorf("`%s` must be specified when `use_add_key` is true", field)
+		}
+		if !enabled && configured {
+			return fmt.Errorf("`%s` can only be specified when `use_add_key` is true", field)
+		}
+	}
+
+	return nil
+}
+
 func servicePrincipalCertificateResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
 
@@ -129,11 +269,7 @@ func servicePrincipalCertificateResourceCreate(ctx context.Context, d *pluginsdk
 
 	credential, err := credentials.KeyCredentialForResource(d)
 	if err != nil {
-		attr := ""
-		if kerr, ok := err.(credentials.CredentialError); ok {
-			attr = kerr.Attr()
-		}
-		return tf.ErrorDiagPathF(err, attr, "Generating certificate credentials for %s", servicePrincipalId)
+		return tf.ErrorDiagPathF(err, credentialErrorAttr(err), "Generating certificate credentials for %s", servicePrincipalId)
 	}
 
 	if credential.KeyId == nil {
@@ -145,7 +281,7 @@ func servicePrincipalCertificateResourceCreate(ctx context.Context, d *pluginsdk
 	tf.LockByName(servicePrincipalResourceName, id.ObjectId)
 	defer tf.UnlockByName(servicePrincipalResourceName, id.ObjectId)
 
-	resp, err := client.GetServicePrincipal(ctx, *servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	resp, err := client.GetServicePrincipal(ctx, *servicePrincipalId, servicePrincipalKeyCredentialsOptions())
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			return tf.ErrorDiagPathF(nil, "service_principal_id", "%s was not found", servicePrincipalId)
@@ -168,36 +304,69 @@ func servicePrincipalCertificateResourceCreate(ctx context.Context, d *pluginsdk
 		}
 	}
 
-	newCredentials = append(newCredentials, *credential)
-
-	properties := stable.ServicePrincipal{
-		KeyCredentials: &newCredentials,
-	}
-	if _, err = client.UpdateServicePrincipal(ctx, *servicePrincipalId, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
-		return tf.ErrorDiagF(err, "Adding certificate for %s", servicePrincipalId)
+	// The addKey action is only available when the service principal already has a valid certificate, otherwise we fall
+	// back to replacing the full set of key credentials and warn that the addition was not additive
+	var diags pluginsdk.Diagnostics
+	useAddKey := d.Get("use_add_key").(bool)
+	if useAddKey && !servicePrincipalHasValidCertificate(servicePrincipal.KeyCredentials, time.Now()) {
+		useAddKey = false
+		diags = append(diags, pluginsdk.Diagnostic{
+			Severity: pluginsdk.DiagWarning,
+			Summary:  fmt.Sprintf("The addKey action could not be used for %s", servicePrincipalId),
+			Detail: "The addKey action requires the service principal to already have a valid certificate, so the certificate was " +
+				"instead added by replacing the full set of key credentials. Key credentials added concurrently by another process " +
+				"may have been overwritten.",
+			AttributePath: cty.GetAttrPath("use_add_key"),
+		})
 	}
 
-	// Wait for the credential to appear in the service principal manifest, this can take several minutes
-	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
-		resp, err := client.GetServicePrincipal(ctx, *servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if useAddKey {
+		proof, err := servicePrincipalCertificateProof(d, *servicePrincipalId, servicePrincipal.KeyCredentials)
 		if err != nil {
-			return pointer.To(false), err
+			return tf.ErrorDiagPathF(err, credentialErrorAttr(err), "Generating proof of possession for %s", servicePrincipalId)
 		}
 
-		servicePrincipal := resp.Model
-		if servicePrincipal == nil {
-			return pointer.To(false), nil
+		request := serviceprincipal.AddKeyRequest{
+			KeyCredential: credential,
+			Proof:         proof,
+		}
+		addKeyResp, err := client.AddKey(ctx, *servicePrincipalId, request, serviceprincipal.DefaultAddKeyOperationOptions())
+		if err != nil {
+			if credentials.IsPolicyViolation(addKeyResp.OData) {
+				return servicePrincipalCertificatePolicyViolationDiag(d, err, *servicePrincipalId)
+			}
+			return tf.ErrorDiagF(err, "Adding certificate for %s", servicePrincipalId)
 		}
 
-		credential := credentials.GetKeyCredential(servicePrincipal.KeyCredentials, id.KeyId)
-		return pointer.To(credential != nil), nil
-	}); err != nil {
+		// The key ID is assigned by the API when using addKey
+		if addKeyResp.Model != nil && addKeyResp.Model.KeyId.GetOrZero() != "" {
+			id.KeyId = addKeyResp.Model.KeyId.GetOrZero()
+		}
+	} else {
+		newCredentials = append(newCredentials, *credential)
+
+		properties := stable.ServicePrincipal{
+			KeyCredentials: &newCredentials,
+		}
+		if resp, err := client.UpdateServicePrincipal(ctx, *servicePrincipalId, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+			if credentials.IsPolicyViolation(resp.OData) {
+				return servicePrincipalCertificatePolicyViolationDiag(d, err, *servicePrincipalId)
+			}
+			return tf.ErrorDiagF(err, "Adding certificate for %s", servicePrincipalId)
+		}
+	}
+
+	// Wait for the credential to appear in the service principal manifest, this can take several minutes
+	if err = consistency.WaitForUpdate(ctx, servicePrincipalKeyCredentialExists(func(ctx context.Context) (serviceprincipal.GetServicePrincipalOperationResponse, error) {
+		return client.GetServicePrincipal(ctx, *servicePrincipalId, servicePrincipalKeyCredentialsOptions())
+	}, id.KeyId)); err != nil {
 		return tf.ErrorDiagF(err, "Waiting for certificate credential for %s", servicePrincipalId)
 	}
 
 	d.SetId(id.String())
 
-	return servicePrincipalCertificateResourceRead(ctx, d, meta)
+	diags = append(diags, credentials.KeyCredentialValidityWarnings(*credential, time.Now())...)
+	return append(diags, servicePrincipalCertificateResourceRead(ctx, d, meta)...)
 }
 
 func servicePrincipalCertificateResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
@@ -210,7 +379,7 @@ func servicePrincipalCertificateResourceRead(ctx context.Context, d *pluginsdk.R
 
 	servicePrincipalId := stable.NewServicePrincipalID(id.ObjectId)
 
-	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, servicePrincipalKeyCredentialsOptions())
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[DEBUG] Service Principal with ID %q for %s credential %q was not found - removing from state!", id.ObjectId, id.KeyType, id.KeyId)
@@ -235,10 +404,18 @@ func servicePrincipalCertificateResourceRead(ctx context.Context, d *pluginsdk.R
 	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
 	tf.Set(d, "key_id", id.KeyId)
 	tf.Set(d, "type", credential.Type.GetOrZero())
+	tf.Set(d, "display_name", credential.DisplayName.GetOrZero())
+	tf.Set(d, "usage", credential.Usage.GetOrZero())
 	tf.Set(d, "start_date", credential.StartDateTime.GetOrZero())
 	tf.Set(d, "end_date", credential.EndDateTime.GetOrZero())
 
-	return nil
+	return credentials.KeyCredentialExpiryWarnings(*credential, d.Get("expiry_warning_days").(int), time.Now())
+}
+
+// servicePrincipalCertificateResourceUpdate only handles changes to `expiry_warning_days` and `maximum_validity`, which
+// are not sent to the API, since all other properties force a new resource
+func servicePrincipalCertificateResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	return servicePrincipalCertificateResourceRead(ctx, d, meta)
 }
 
 func servicePrincipalCertificateResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
@@ -254,7 +431,7 @@ func servicePrincipalCertificateResourceDelete(ctx context.Context, d *pluginsdk
 
 	servicePrincipalId := stable.NewServicePrincipalID(id.ObjectId)
 
-	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, servicePrincipalKeyCredentialsOptions())
 	if err != nil {
 		if response.WasNotFound(resp.HttpResponse) {
 			return nil
@@ -264,7 +441,27 @@ func servicePrincipalCertificateResourceDelete(ctx context.Context, d *pluginsdk
 
 	servicePrincipal := resp.Model
 	if servicePrincipal == nil {
-		return tf.ErrorDiagF(err, "Retrieving %s", servicePrincipalId)
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	if d.Get("use_add_key").(bool) {
+		proof, err := servicePrincipalCertificateProof(d, servicePrincipalId, servicePrincipal.KeyCredentials)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, credentialErrorAttr(err), "Generating proof of possession for %s", servicePrincipalId)
+		}
+
+		request := serviceprincipal.RemoveKeyRequest{
+			KeyId: pointer.To(id.KeyId),
+			Proof: proof,
+		}
+		if resp, err := client.RemoveKey(ctx, servicePrincipalId, request, serviceprincipal.DefaultRemoveKeyOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return nil
+			}
+			return tf.ErrorDiagF(err, "Removing certificate credential %q from %s", id.KeyId, servicePrincipalId)
+		}
+
+		return servicePrincipalCertificateWaitForDeletion(ctx, client, servicePrincipalId, id.KeyId)
 	}
 
 	newCredentials := make([]stable.KeyCredential, 0)
@@ -279,30 +476,153 @@ func servicePrincipalCertificateResourceDelete(ctx context.Context, d *pluginsdk
 	properties := stable.ServicePrincipal{
 		KeyCredentials: &newCredentials,
 	}
-	if _, err := client.UpdateServicePrincipal(ctx, servicePrincipalId, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+	if resp, err := client.UpdateServicePrincipal(ctx, servicePrincipalId, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+		// The service principal was deleted since it was retrieved, so the credential no longer exists
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
 		return tf.ErrorDiagF(err, "Removing certificate credential %q from %s", id.KeyId, servicePrincipalId)
 	}
 
-	// Wait for service principal certificate to be deleted
-	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
-		resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	return servicePrincipalCertificateWaitForDeletion(ctx, client, servicePrincipalId, id.KeyId)
+}
+
+func servicePrincipalCertificateWaitForDeletion(ctx context.Context, client *serviceprincipal.ServicePrincipalClient, servicePrincipalId stable.ServicePrincipalId, keyId string) pluginsdk.Diagnostics {
+	if err := consistency.WaitForDeletion(ctx, servicePrincipalKeyCredentialExists(func(ctx context.Context) (serviceprincipal.GetServicePrincipalOperationResponse, error) {
+		return client.GetServicePrincipal(ctx, servicePrincipalId, servicePrincipalKeyCredentialsOptions())
+	}, keyId)); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of certificate credential %q from %s", keyId, servicePrincipalId)
+	}
+
+	return nil
+}
+
+// servicePrincipalCertificatePolicyViolationDiag returns an error diagnostic for a certificate which was rejected by an
+// app management policy, such as a policy restricting the maximum lifetime of certificates
+func servicePrincipalCertificatePolicyViolationDiag(d *pluginsdk.ResourceData, err error, servicePrincipalId stable.ServicePrincipalId) pluginsdk.Diagnostics {
+	attr := credentials.EndDateAttr(d)
+	return tf.ErrorDiagPathF(err, attr, "The certificate for %s was rejected by an app management policy. Check that the validity period determined by `%s` is permitted by the policies applying to the service principal", servicePrincipalId, attr)
+}
+
+// credentialErrorAttr returns the attribute associated with a credentials.CredentialError, if any.
+func credentialErrorAttr(err error) string {
+	if kerr, ok := err.(credentials.CredentialError); ok {
+		return kerr.Attr()
+	}
+	return ""
+}
+
+// servicePrincipalCertificateProof returns a signed proof of possession token for the addKey and removeKey actions. The
+// proof certificate must match one of the existing key credentials for the service principal.
+func servicePrincipalCertificateProof(d *pluginsdk.ResourceData, servicePrincipalId stable.ServicePrincipalId, keyCredentials *[]stable.KeyCredential) (*string, error) {
+	v, ok := d.GetOk("proof_certificate")
+	if !ok {
+		return nil, errors.New("`proof_certificate` must be specified when `use_add_key` is true")
+	}
+
+	proof, err := credentials.NewProofOfPossession(v.(string), d.Get("proof_private_key").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = credentials.ValidateProofOfPossession(*proof, keyCredentials); err != nil {
+		return nil, err
+	}
+
+	token, err := proof.Token(servicePrincipalId.ServicePrincipalId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(token), nil
+}
+
+// servicePrincipalHasValidCertificate reports whether any of the provided key credentials is an unexpired certificate,
+// which is a prerequisite for using the addKey action.
+func servicePrincipalHasValidCertificate(keyCredentials *[]stable.KeyCredential, now time.Time) bool {
+	if keyCredentials == nil {
+		return false
+	}
+
+	for _, cred := range *keyCredentials {
+		if !strings.EqualFold(cred.Type.GetOrZero(), KeyCredentialTypeAsymmetricX509Cert) {
+			continue
+		}
+		endDate, err := time.Parse(time.RFC3339, cred.EndDateTime.GetOrZero())
+		if err != nil {
+			continue
+		}
+		if endDate.After(now) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// servicePrincipalKeyCredentialsOptions returns options for retrieving only the key credentials of a service principal,
+// which avoids retrieving the full manifest when only the key credentials are needed
+func servicePrincipalKeyCredentialsOptions() serviceprincipal.GetServicePrincipalOperationOptions {
+	return serviceprincipal.GetServicePrincipalOperationOptions{
+		Select: pointer.To([]string{"keyCredentials"}),
+	}
+}
+
+// servicePrincipalKeyCredentialExists returns a consistency.ChangeFunc that retrieves the service principal on every
+// invocation and reports whether the key credential is present in the freshly returned model. A service principal that
+// cannot be found is treated as not having the credential.
+func servicePrincipalKeyCredentialExists(getServicePrincipal func(context.Context) (serviceprincipal.GetServicePrincipalOperationResponse, error), keyId string) consistency.ChangeFunc {
+	return func(ctx context.Context) (*bool, error) {
+		resp, err := getServicePrincipal(ctx)
 		if err != nil {
 			if response.WasNotFound(resp.HttpResponse) {
-				return pointer.To(true), nil
+				return pointer.To(false), nil
 			}
 			return nil, err
 		}
 
-		servicePrincipal := resp.Model
-		if servicePrincipal == nil {
+		if resp.Model == nil {
 			return pointer.To(false), nil
 		}
 
-		credential := credentials.GetKeyCredential(servicePrincipal.KeyCredentials, id.KeyId)
+		credential := credentials.GetKeyCredential(resp.Model.KeyCredentials, keyId)
 		return pointer.To(credential != nil), nil
-	}); err != nil {
-		return tf.ErrorDiagF(err, "Waiting for deletion of certificate credential %q from %s", id.KeyId, servicePrincipalId)
 	}
+}
 
-	return nil
+// servicePrincipalCertificateResourceImport resolves an import ID specifying a certificate thumbprint to the ID of the matching
+// certificate credential
+func servicePrincipalCertificateResourceImport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	id, err := parse.CertificateID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// This property is not returned by the API, so is set to its default value when importing
+	tf.Set(d, "expiry_warning_days", credentials.DefaultExpiryWarningDays)
+
+	if id.Thumbprint == "" {
+		return []*pluginsdk.ResourceData{d}, nil
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ObjectId)
+
+	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, servicePrincipalKeyCredentialsOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", servicePrincipalId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", servicePrincipalId)
+	}
+
+	keyId, err := credentials.GetVerifyKeyCredentialIdFromThumbprint(resp.Model.KeyCredentials, id.Thumbprint)
+	if err != nil {
+		return nil, fmt.Errorf("resolving certificate for %s: %+v", servicePrincipalId, err)
+	}
+
+	d.SetId(parse.NewCredentialID(id.ObjectId, "certificate", keyId).String())
+
+	return []*pluginsdk.ResourceData{d}, nil
 }
@@ -0,0 +1,206 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/client"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+)
+
+func TestServicePrincipalKeyCredentialExists_delayedAppearance(t *testing.T) {
+	keyId := "11111111-1111-1111-1111-111111111111"
+
+	// The credential only shows up in the third response, as happens when the directory is eventually consistent
+	responses := [][]stable.KeyCredential{
+		{},
+		{{KeyId: nullable.Value("22222222-2222-2222-2222-222222222222")}},
+		{{KeyId: nullable.Value("22222222-2222-2222-2222-222222222222")}, {KeyId: nullable.Value(keyId)}},
+	}
+
+	calls := 0
+	f := servicePrincipalKeyCredentialExists(func(ctx context.Context) (serviceprincipal.GetServicePrincipalOperationResponse, error) {
+		keyCredentials := responses[calls]
+		calls++
+		return serviceprincipal.GetServicePrincipalOperationResponse{
+			HttpResponse: &http.Response{StatusCode: http.StatusOK},
+			Model:        &stable.ServicePrincipal{KeyCredentials: &keyCredentials},
+		}, nil
+	}, keyId)
+
+	for i, expected := range []bool{false, false, true} {
+		exists, err := f(context.Background())
+		if err != nil {
+			t.Fatalf("poll %d: unexpected error: %+v", i, err)
+		}
+		if exists == nil {
+			t.Fatalf("poll %d: result was nil", i)
+		}
+		if *exists != expected {
+			t.Fatalf("poll %d: expected %t, got %t", i, expected, *exists)
+		}
+	}
+
+	if calls != len(responses) {
+		t.Fatalf("expected the service principal to be retrieved %d times, got %d", len(responses), calls)
+	}
+}
+
+func TestServicePrincipalKeyCredentialExists_servicePrincipalNotFound(t *testing.T) {
+	f := servicePrincipalKeyCredentialExists(func(ctx context.Context) (serviceprincipal.GetServicePrincipalOperationResponse, error) {
+		return serviceprincipal.GetServicePrincipalOperationResponse{
+			HttpResponse: &http.Response{StatusCode: http.StatusNotFound},
+		}, errors.New("not found")
+	}, "11111111-1111-1111-1111-111111111111")
+
+	exists, err := f(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if exists == nil || *exists {
+		t.Fatalf("expected credential to be reported as absent")
+	}
+}
+
+func TestServicePrincipalHasValidCertificate(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name           string
+		keyCredentials *[]stable.KeyCredential
+		expected       bool
+	}{
+		{
+			name:           "nil",
+			keyCredentials: nil,
+			expected:       false,
+		},
+		{
+			name:           "empty",
+			keyCredentials: &[]stable.KeyCredential{},
+			expected:       false,
+		},
+		{
+			name: "expired",
+			keyCredentials: &[]stable.KeyCredential{
+				{Type: nullable.Value(KeyCredentialTypeAsymmetricX509Cert), EndDateTime: nullable.Value("2024-05-31T23:59:59Z")},
+			},
+			expected: false,
+		},
+		{
+			name: "not a certificate",
+			keyCredentials: &[]stable.KeyCredential{
+				{Type: nullable.Value("Symmetric"), EndDateTime: nullable.Value("2025-01-01T00:00:00Z")},
+			},
+			expected: false,
+		},
+		{
+			name: "valid",
+			keyCredentials: &[]stable.KeyCredential{
+				{Type: nullable.Value(KeyCredentialTypeAsymmetricX509Cert), EndDateTime: nullable.Value("2024-05-31T23:59:59Z")},
+				{Type: nullable.Value(KeyCredentialTypeAsymmetricX509Cert), EndDateTime: nullable.Value("2025-01-01T00:00:00Z")},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := servicePrincipalHasValidCertificate(tc.keyCredentials, now); actual != tc.expected {
+				t.Fatalf("expected %t, got %t", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestServicePrincipalCertificateResourceDelete_servicePrincipalNotFound(t *testing.T) {
+	servicePrincipalId := "11111111-1111-1111-1111-111111111111"
+	keyId := "22222222-2222-2222-2222-222222222222"
+
+	cases := []struct {
+		TestName string
+
+		// Whether the service principal is deleted after it has been retrieved, but before the credential is removed
+		DeletedMidApply bool
+	}{
+		{
+			TestName: "AlreadyDeleted",
+		},
+		{
+			TestName:        "DeletedMidApply",
+			DeletedMidApply: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			var mu sync.Mutex
+			requests := make([]string, 0)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requests = append(requests, r.Method)
+				mu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				if tc.DeletedMidApply && r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_, _ = w.Write([]byte(`{"id":"` + servicePrincipalId + `","keyCredentials":[{"keyId":"` + keyId + `"}]}`))
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"error":{"code":"Request_ResourceNotFound","message":"Resource does not exist"}}`))
+			}))
+			defer server.Close()
+
+			servicePrincipalClient, err := serviceprincipal.NewServicePrincipalClientWithBaseURI(environments.NewApiEndpoint("MicrosoftGraph", server.URL, nil))
+			if err != nil {
+				t.Fatalf("building client: %+v", err)
+			}
+			meta := &clients.Client{
+				ServicePrincipals: &client.Client{
+					ServicePrincipalClient: servicePrincipalClient,
+				},
+			}
+
+			d := schema.TestResourceDataRaw(t, servicePrincipalCertificateResource().Schema, map[string]interface{}{})
+			d.SetId(parse.NewCredentialID(servicePrincipalId, "certificate", keyId).String())
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			defer cancel()
+
+			if diags := servicePrincipalCertificateResourceDelete(ctx, d, meta); diags.HasError() {
+				t.Fatalf("expected deletion to succeed, got: %+v", diags)
+			}
+
+			expected := []string{http.MethodGet}
+			if tc.DeletedMidApply {
+				expected = append(expected, http.MethodPatch)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if len(requests) < len(expected) {
+				t.Fatalf("expected requests %v, got %v", expected, requests)
+			}
+			for i, method := range expected {
+				if requests[i] != method {
+					t.Fatalf("expected requests %v, got %v", expected, requests)
+				}
+			}
+		})
+	}
+}
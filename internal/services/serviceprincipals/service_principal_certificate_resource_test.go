@@ -6,6 +6,8 @@ package serviceprincipals_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 	"time"
 
@@ -13,10 +15,12 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/parse"
 )
 
@@ -67,6 +71,69 @@ GLNs0/0Ayfjh6JllWqW482dIIqMErl6s5DuK`
 
 const servicePrincipalCertificateHex string = `30820314308201fc020900af1e9fafa297ce39300d06092a864886f70d01010b0500304c3116301406035504030c0d6861736869636f72707465737431183016060355040a0c0f4861736869436f72702c20496e632e310b300906035504080c024341310b3009060355040613025553301e170d3231303330393131303231335a170d3331303330373131303231335a304c3116301406035504030c0d6861736869636f72707465737431183016060355040a0c0f4861736869436f72702c20496e632e310b300906035504080c024341310b300906035504061302555330820122300d06092a864886f70d01010105000382010f003082010a028201010095599be699a8012bd9e69c43e8210188f62a0036fbb5e087579e11470bf56898d27a23d45bfd56350a28210174334cb315d1e6c31dc74a8c42910c30c553003ecbaa14955a6ecfde02be35369c500a771b8bebca95b99a0166da21d89dc5e51ed635c1d8dd185d10a0ecfb3c206034528721bd11b2a5f722cb893aff111faeb40f165acf78379abab3548c9e08a2d4c12f358d017f674f51cacef96360d8380343f0bb33cd3a6831512a407a23db0e84280ff61c414296cc1956f2ab7d667b91306362e95d9f9d07932db95fb2179a6af5f23f5d6cc89f68c33ea60df7d910b1dd4980bb8f610ea86f8f9dd779e4c2ce69cf1c1780fd63dd4f90b28a8cd22b5b0203010001300d06092a864886f70d01010b05000382010100655492e7d7b0459a281ba92f09231d3f4536c6a244682ca760ed26404095a7db48c3b6e9d2a3eb29673b99e2e4bc59b819f92143d6bad0cb7b3417d1ecb19141c031b29f7d73fec1a39305a9a003fae6fd4309a373980b33c4c3628e16254a1bfad60d8810ef50f7c6e0056f4b3709894469c6c73d2e7f3799b94afd215f448dea0908a3c86f024295a6f44f2fe4442d772603611ed345c185702ba343b78e8846c8f1a9643a6d56a4ac444e95fba863cb31523ba78fe841daf65391929a3dcd2c16f5108cd1f11d7e2331e4f00a8a500a8faa17794f75ef86ec2d4c35fdf490366592cfbe18b36cd3fd00c9f8e1e899655aa5b8f3674822a304ae5eace43b8a`
 
+// openssl pkcs12 -export -in server.crt -inkey server.key -passout pass:Passw0rd -out server.p12
+// base64 server.p12
+
+// The following certificate bundle will expire on October 13, 2036
+const servicePrincipalCertificatePfxPassword string = "Passw0rd"
+
+const servicePrincipalCertificatePfx string = `MIIKTwIBAzCCCgUGCSqGSIb3DQEHAaCCCfYEggnyMIIJ7jCCBGIGCSqGSIb3DQEH
+BqCCBFMwggRPAgEAMIIESAYJKoZIhvcNAQcBMFcGCSqGSIb3DQEFDTBKMCkGCSqG
+SIb3DQEFDDAcBAhShsN4+8qEWQICCAAwDAYIKoZIhvcNAgkFADAdBglghkgBZQME
+ASoEENI0Z2J1zjz6jYSQQQRW792AggPgIym75XEhNT7BIir1we5Do4+b2hjLvsgS
+4ciKofThIxjeJ0CT9bm3smzFhq7jpOnBMbLLLgSWN57fTfJi+j2VZdAYxhoZ2V7x
+uF0GhakRMCTTD6dxY9y3TMMYgFpw3Gd2i49AIDXlAV8wLvD7VYoTwQNft/e+Phlf
+T3Uodh5+BjeKfC1RwwM1kjjUaB1u9lCQ1gMtwtWnpkVI/3AMNtHGpGdjWkvZiL+0
+3bHho2o4u6TOYTMI7bPVwsu9cNqj+lBVjYf1MK9AjyIxEvW6FvoWH/FKBXC+CEKg
+N+CNmF4UbLJqIbC24d43iljYzwVy+zoCRP+PBlzjhzSI4qQvJgxlF9Vq2PhHiPrE
+1M4YoCHKAmG/2zawn2eGWuTm5bM+nHTetr+sofWZ87SWkHX5/oWDX3EfoGHeVaRF
+vMrrH5rpHLJTqbpKjlPhIMcYem9f4CQhXwYi1C8HicbksWSoUh/lUMVeP6wdmtFJ
+UXebooJr0wYvgTn5wS5v2b/OIuO3uJlGS1I0NwLJIu9AKFEL5FltyiQ/d146sE3J
+4c2plS8MKEpKfLWrEbUilbuX62Ek4FpZhAWC6rAyHfcm+MkertiNAaVoVweG+ORl
+NWwZ98G9yN462aFvPKQMACBQ4/KS8di/QIbqT05mDks4GdbZkaxw3h4xyGPQRasX
+Vywfak/Y99RYWWEk2IA8rDdvbzZ5p/dZre3vqP8/HSe+R1Oz/AA7Q39735OP2dx3
+aAhRrpo1euKwr2HmRgXKyOl28LZNEuaC6lfDPaRHCuN1lnrbyFV/W18qFt8fLEjN
+fG8ILisa7JSgGr1wMVYfXpmiu+EGWELdCAmQwUJu56jvwa1DiFLdbjZIjHgXbJT2
+blM4tXw283RzsJ9w7kNsveqwYFCkKe3I16xBvljTI0k31S1TLBPI3JW5hWj2XxCO
+zaw4Akfec8IWqvotk7WoW1DcIQnO5xCZjtKtkkG7QnFIhn8YNnPqy680aCRthX4Q
+fXoGtbnYw003S0ADEELHwk0gXFtNNBthsPTUN1HLDoVghNwa5SIzT43DgXpijib6
+T/9QogOLQN2xy9oW+KXQdOBud0IYB6FMMy/TBxgX2gMQbnPNkpj2NrBacrNWuiNX
+Bnh6tuHDG14Ak7mwEugl+Ab8ZZqzwVO7RMmLNk07gxkh8zCpqpD0yk6BmMJcsLTZ
+4xeolq0nCFGZhi2patuyLMXEdqBPjtK8whHFzNGqzH0tVPIdIWbAxGyAVwgttEQs
+Qhllrv33HYINgHE6CGbwGE8DPEttRt6v/3+uGCK6SsZtre0lnnDx0J72D47CLag1
+3/S0mF2UVE8wggWEBgkqhkiG9w0BBwGgggV1BIIFcTCCBW0wggVpBgsqhkiG9w0B
+DAoBAqCCBTEwggUtMFcGCSqGSIb3DQEFDTBKMCkGCSqGSIb3DQEFDDAcBAgSWUdz
+CjrQIQICCAAwDAYIKoZIhvcNAgkFADAdBglghkgBZQMEASoEEPccl/sWMLkSCgIZ
+HahNeJsEggTQlbs7kBFjewKGA1ezpRPsozGSVsL/VRlQgOEnMrLPWd5Y21RdSn2h
+aE5iE5GHBvYWef/c7g3MSDNGxufhIxJ8QWMOZk1ChxQoYjv5ngS1SSou7e1aZQS4
+UB4Ef9l9ArRgIoRMjSUHCBTp4xEsPwJdg6CbD5xf1u40D7i2VsZ7j3jlhkKXUkZj
+QvlmWb5UitBm6uhQNfzPmKBgugI91DXgIyLkli2hEPGhYm7FUKtnnc6cU9LCvlrJ
+TTkMyAjupXGf2TVB2j0P/2AAAGykf3CLnQaZ1xGqGIiQRRd858Ks5w8J8lvYJK3W
+XpFXJQ6IUdPk/LceOZ0E1Kb9Ua3/e2XSVG7sLwogoVdlHWmtR6R5Snojjsg75pG9
+EDjIwq6Dv1ed4L748oJB6gUgGq0PF67aiZ2Otz+o0BgkZaXQ5IF76Nw+7XwZ67u8
+Ex5AVe96Dx443oHi6I6VZ/UKXKV0rEU2LCUyYML05PTyvBIyaH89cCv93NHgCWsn
+SrRv7CBE1qgBvIm7gekPZPfgzRdi6KChlaJRBd5tlFK8tc/PSkDSi9NwHSMIfWAE
+5Go8LUu/07YTvoWRzRc0e1i97/zr6o+uB6iVPKxHU+l/gkauVd7gZ04nCR47SjL6
++Sklmy5Twyo7GSk+KetecwgxdeHD7w8sBlSw1ABX1QHirzI4Z/a613jvT86sgCho
+m2nFZMLUH+y6jN6iTbQO7SYb8RzOwGej6rABNxptyCtaBSJIzj2X4o9g8r5+yOKl
+ME1/BHchwkOqYxcTG/0mcpr5bjK6aXGyLr8l+ZgJjpXpBrUwipPw6xAjByp3rqPX
+rspJN3pRi4tHnwUw1DD+jYq5Pr6DDDjIvq+YYdmkrSPdGGI8os7VchRJd1MXS+k9
++VVAO/gYG4kmWGfU9/nWLBqMyiMTBw0N4XrQCV2Nl/mMKbwLBATbmVaPRRHDRtIl
+9OcAaqtz7Gs2JZLgJsMwGxjOhI3G3TJzEz/0vZlSEzxyQheiixWWDQuKyYxa7pys
+/03I5exsKOgjFveX02SkFzzOjkCx0qXa7jSkqXr8qlL853tXokdKqfFQMtM+WQa4
+dSGxnpkVWoddqPYNsdL9f4jRszWLFKFuTTFDtf5l/nbRa13Bc8DBKZaTo5tcz7tG
+rX/YY5JRT/8F7IyKg3pxqYSvQ16fcmcsrNiql/vah3tNBHWQmZbfaYSMpIemxTKZ
+j7KW+VvJfjdVd/uPbNKx6oskGt/C7mrXlQPHVH2yG9N/9flwqtmCELWGgzi1i9oX
+drdb8hbe8kTwNbDZRXUIcpOrSDbHfMVQVJk9cGqQuSrfvQA/JEx85Ju2HSQZR4Ck
+j5+H2un6UqG8tUawlYp0ghmuN7vAw5qP76dvu9zX9y+YcsavtPtmfy41aiMu/dCw
+a8n0lnnJq1WCV/qGDNufWxvYCZvLMpRhq24KFLEZaG4rC2XSE9jL1eLNGasCtb3C
+9sVIHaUjx1s8CEMBjcfysZDqJ4Orxeg6mGGtWwMwCKtcvdSKEH354Sk29GrpWlZC
+9AUwdQgbuZSPo8a2oG5XeTqNYvJbDmlAZ+r0u27RtIr6I6kq7vZbxHHPwdcmvwBX
+CN171ONK7/yergT9FAA9HDAgf3Iuxf3PszpqLbXAVzFUtD+KHIRxfCUxJTAjBgkq
+hkiG9w0BCRUxFgQUcckabwqsNiCSt00gOZ3RxBOl8WYwQTAxMA0GCWCGSAFlAwQC
+AQUABCA4Q+/t+aJTaK7rDa4o2EmA+oWOwOSB2u/UdG/6Y1uNSwQIB8fhTCaWuakC
+AggA`
+
 type ServicePrincipalCertificateResource struct{}
 
 func TestAccServicePrincipalCertificate_basic(t *testing.T) {
@@ -80,6 +147,10 @@ func TestAccServicePrincipalCertificate_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("usage").HasValue("Verify"),
+				check.That(data.ResourceName).Key("type").HasValue("AsymmetricX509Cert"),
+				check.That(data.ResourceName).Key("start_date").Exists(),
+				check.That(data.ResourceName).Key("end_date").Exists(),
 			),
 		},
 		data.ImportStep("encoding", "value"),
@@ -98,6 +169,7 @@ func TestAccServicePrincipalCertificate_complete(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestServicePrincipalCertificate-%d", data.RandomInteger)),
 			),
 		},
 		data.ImportStep("encoding", "end_date_relative", "value"),
@@ -138,6 +210,23 @@ func TestAccServicePrincipalCertificate_hexCert(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipalCertificate_pfxCert(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ServicePrincipalCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.pfxCert(data, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "password", "value"),
+	})
+}
+
 func TestAccServicePrincipalCertificate_relativeEndDate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
 	r := ServicePrincipalCertificateResource{}
@@ -155,6 +244,92 @@ func TestAccServicePrincipalCertificate_relativeEndDate(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipalCertificate_maximumValidityExceeded(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
+	r := ServicePrincipalCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.maximumValidity(data, "1440h"),
+			ExpectError: regexp.MustCompile("`end_date_relative`: the validity period .* exceeds the maximum"),
+		},
+		{
+			Config: r.maximumValidity(data, "17520h"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("maximum_validity").HasValue("17520h"),
+			),
+		},
+	})
+}
+
+func TestAccServicePrincipalCertificate_addKeyWithoutProof(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
+	r := ServicePrincipalCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.addKeyWithoutProof(data),
+			ExpectError: regexp.MustCompile("`proof_certificate` must be specified when `use_add_key` is true"),
+		},
+	})
+}
+
+func TestAccServicePrincipalCertificate_addKeyWithKeyId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
+	r := ServicePrincipalCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.addKeyWithKeyId(data),
+			ExpectError: regexp.MustCompile("`key_id` cannot be specified when `use_add_key` is true"),
+		},
+	})
+}
+
+func TestAccServicePrincipalCertificate_relativeStartDate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
+	r := ServicePrincipalCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.relativeStartDate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+				check.That(data.ResourceName).Key("start_date").Exists(),
+				check.That(data.ResourceName).Key("end_date").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "end_date_relative", "start_date_relative", "value"),
+	})
+}
+
+func TestAccServicePrincipalCertificate_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ServicePrincipalCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotation(data, endDate, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "rotate_when_changed", "value"),
+		{
+			Config: r.rotation(data, endDate, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_id").Exists(),
+			),
+		},
+		data.ImportStep("encoding", "rotate_when_changed", "value"),
+	})
+}
+
 func TestAccServicePrincipalCertificate_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
 	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
@@ -172,6 +347,28 @@ func TestAccServicePrincipalCertificate_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipalCertificate_importByThumbprint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificate", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ServicePrincipalCertificateResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			ResourceName:            data.ResourceName,
+			ImportState:             true,
+			ImportStateVerify:       true,
+			ImportStateVerifyIgnore: []string{"encoding", "value"},
+			ImportStateIdFunc:       r.thumbprintImportId(data),
+		},
+	})
+}
+
 func (r ServicePrincipalCertificateResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.ServicePrincipals.ServicePrincipalClient
 
@@ -201,6 +398,23 @@ func (r ServicePrincipalCertificateResource) Exists(ctx context.Context, clients
 	return pointer.To(false), nil
 }
 
+func (ServicePrincipalCertificateResource) thumbprintImportId(data acceptance.TestData) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", data.ResourceName)
+		}
+
+		thumbprint, err := credentials.GetTokenSigningCertificateThumbprint([]byte(servicePrincipalCertificatePem))
+		if err != nil {
+			return "", err
+		}
+
+		objectId := strings.Split(rs.Primary.ID, "/")[0]
+		return fmt.Sprintf("%s/certificate/%s%s", objectId, credentials.CertificateThumbprintPrefix, thumbprint), nil
+	}
+}
+
 func (ServicePrincipalCertificateResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 resource "azuread_application" "test" {
@@ -239,11 +453,13 @@ resource "azuread_service_principal_certificate" "test" {
   start_date           = "%[3]s"
   end_date             = "%[4]s"
   encoding             = "pem"
+  usage                = "Verify"
+  display_name         = "acctestServicePrincipalCertificate-%[6]d"
   value                = <<EOT
 %[5]s
 EOT
 }
-`, r.template(data), data.RandomID, startDate, endDate, servicePrincipalCertificatePem)
+`, r.template(data), data.RandomID, startDate, endDate, servicePrincipalCertificatePem, data.RandomInteger)
 }
 
 func (r ServicePrincipalCertificateResource) base64Cert(data acceptance.TestData, endDate string) string {
@@ -278,6 +494,23 @@ EOT
 `, r.template(data), endDate, servicePrincipalCertificateHex)
 }
 
+func (r ServicePrincipalCertificateResource) pfxCert(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  type                 = "AsymmetricX509Cert"
+  end_date             = "%[2]s"
+  encoding             = "pfx"
+  password             = "%[3]s"
+  value                = <<EOT
+%[4]s
+EOT
+}
+`, r.template(data), endDate, servicePrincipalCertificatePfxPassword, servicePrincipalCertificatePfx)
+}
+
 func (r ServicePrincipalCertificateResource) relativeEndDate(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -293,6 +526,94 @@ EOT
 `, r.template(data), servicePrincipalCertificatePem)
 }
 
+func (r ServicePrincipalCertificateResource) maximumValidity(data acceptance.TestData, maximumValidity string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  end_date_relative    = "2280h"
+  maximum_validity     = "%[3]s"
+  type                 = "AsymmetricX509Cert"
+  value                = <<EOT
+%[2]s
+EOT
+}
+`, r.template(data), servicePrincipalCertificatePem, maximumValidity)
+}
+
+func (r ServicePrincipalCertificateResource) addKeyWithoutProof(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  end_date_relative    = "2280h"
+  type                 = "AsymmetricX509Cert"
+  use_add_key          = true
+  value                = <<EOT
+%[2]s
+EOT
+}
+`, r.template(data), servicePrincipalCertificatePem)
+}
+
+func (r ServicePrincipalCertificateResource) addKeyWithKeyId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  key_id               = "%[3]s"
+  end_date_relative    = "2280h"
+  type                 = "AsymmetricX509Cert"
+  use_add_key          = true
+  value                = <<EOT
+%[2]s
+EOT
+}
+`, r.template(data), servicePrincipalCertificatePem, data.RandomID)
+}
+
+func (r ServicePrincipalCertificateResource) relativeStartDate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  start_date_relative  = "-5m"
+  end_date_relative    = "2280h"
+  type                 = "AsymmetricX509Cert"
+  value                = <<EOT
+%[2]s
+EOT
+}
+`, r.template(data), servicePrincipalCertificatePem)
+}
+
+func (r ServicePrincipalCertificateResource) rotation(data acceptance.TestData, endDate, rotation string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificate" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  type                 = "AsymmetricX509Cert"
+  end_date             = "%[2]s"
+  value                = <<EOT
+%[3]s
+EOT
+
+  rotate_when_changed = {
+    rotation = "%[4]s"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, r.template(data), endDate, servicePrincipalCertificatePem, rotation)
+}
+
 func (r ServicePrincipalCertificateResource) requiresImport(data acceptance.TestData, endDate string) string {
 	return fmt.Sprintf(`
 %[1]s
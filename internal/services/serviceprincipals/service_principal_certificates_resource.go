@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func servicePrincipalCertificatesResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalCertificatesResourceCreate,
+		ReadContext:   servicePrincipalCertificatesResourceRead,
+		UpdateContext: servicePrincipalCertificatesResourceUpdate,
+		DeleteContext: servicePrincipalCertificatesResourceDelete,
+
+		CustomizeDiff: servicePrincipalCertificatesResourceCustomizeDiff,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(10 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := stable.ParseServicePrincipalID(id)
+			return err
+		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			// The certificate data is not returned by the API, so no certificates can be imported. When `exclusive` is
+			// subsequently enabled, all existing key credentials not specified in configuration will be removed.
+			tf.Set(d, "exclusive", false)
+			return []*pluginsdk.ResourceData{d}, nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The resource ID of the service principal for which to manage certificates",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"certificate": {
+				Description: "One or more certificates to associate with the service principal",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Set:         servicePrincipalCertificateHash,
+				Elem:        schemaServicePrincipalCertificate(),
+			},
+
+			"exclusive": {
+				Description: "Whether to remove key credentials of the service principal which are not specified in `certificate` blocks, including those added outside of Terraform or by other resources",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"key_ids": {
+				Description: "The key IDs of all key credentials currently assigned to the service principal",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func servicePrincipalCertificatesResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if diff.HasChange("certificate") {
+		return diff.SetNewComputed("key_ids")
+	}
+
+	// When exclusive, plan an update whenever the service principal has key credentials that are not configured, so
+	// that they are removed on the next apply
+	if diff.Get("exclusive").(bool) {
+		configured := servicePrincipalCertificatesKeyIds(diff.Get("certificate").(*pluginsdk.Set).List())
+		for _, keyId := range tf.ExpandStringSlice(diff.Get("key_ids").([]interface{})) {
+			if !servicePrincipalKeyIdInSlice(configured, keyId) {
+				return diff.SetNewComputed("key_ids")
+			}
+		}
+	}
+
+	return nil
+}
+
+func servicePrincipalCertificatesResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	tf.LockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+
+	diags := servicePrincipalCertificatesApply(ctx, d, meta, *servicePrincipalId)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(servicePrincipalId.ID())
+
+	return append(diags, servicePrincipalCertificatesResourceRead(ctx, d, meta)...)
+}
+
+func servicePrincipalCertificatesResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing service principal ID")
+	}
+
+	tf.LockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+
+	diags := servicePrincipalCertificatesApply(ctx, d, meta, *servicePrincipalId)
+	if diags.HasError() {
+		return diags
+	}
+
+	return append(diags, servicePrincipalCertificatesResourceRead(ctx, d, meta)...)
+}
+
+func servicePrincipalCertificatesResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing service principal ID")
+	}
+
+	resp, err := client.GetServicePrincipal(ctx, *servicePrincipalId, servicePrincipalKeyCredentialsOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", servicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", servicePrincipalId)
+	}
+
+	servicePrincipal := resp.Model
+	if servicePrincipal == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	certificates := flattenServicePrincipalCertificates(d.Get("certificate").(*pluginsdk.Set).List(), servicePrincipal.KeyCredentials)
+
+	keyIds := make([]string, 0)
+	for _, credential := range pointer.From(servicePrincipal.KeyCredentials) {
+		keyIds = append(keyIds, credential.KeyId.GetOrZero())
+	}
+
+	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
+	tf.Set(d, "certificate", certificates)
+	tf.Set(d, "key_ids", keyIds)
+
+	managed := make([]string, 0)
+	for _, certificate := range certificates {
+		managed = append(managed, certificate["key_id"].(string))
+	}
+
+	unmanaged := servicePrincipalUnmanagedKeyIds(servicePrincipal.KeyCredentials, managed)
+	if len(unmanaged) == 0 {
+		return nil
+	}
+
+	if d.Get("exclusive").(bool) {
+		return pluginsdk.Diagnostics{{
+			Severity: pluginsdk.DiagWarning,
+			Summary:  fmt.Sprintf("%s has %d key credential(s) not managed by this resource", servicePrincipalId, len(unmanaged)),
+			Detail: fmt.Sprintf("The key credentials [%s] will be removed on the next apply, since `exclusive` is enabled. If these are "+
+				"managed by an `azuread_service_principal_certificate` resource, or by `certificate` blocks of an `azuread_service_principal` "+
+				"resource, for the same service principal, the resources will conflict.", strings.Join(unmanaged, ", ")),
+		}}
+	}
+
+	return pluginsdk.Diagnostics{{
+		Severity: pluginsdk.DiagWarning,
+		Summary:  fmt.Sprintf("%s has %d key credential(s) not managed by this resource", servicePrincipalId, len(unmanaged)),
+		Detail: fmt.Sprintf("The key credentials [%s] are retained, since `exclusive` is disabled. Since the full set of key credentials is "+
+			"replaced when this resource is applied, key credentials added concurrently by an `azuread_service_principal_certificate` "+
+			"resource, or by `certificate` blocks of an `azuread_service_principal` resource, for the same service principal may be lost.", strings.Join(unmanaged, ", ")),
+	}}
+}
+
+func servicePrincipalCertificatesResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing service principal ID")
+	}
+
+	tf.LockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+
+	resp, err := client.GetServicePrincipal(ctx, *servicePrincipalId, servicePrincipalKeyCredentialsOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", servicePrincipalId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	// Only the certificates known to Terraform are removed, regardless of the `exclusive` setting
+	managed := servicePrincipalCertificatesKeyIds(d.Get("certificate").(*pluginsdk.Set).List())
+	keyCredentials := servicePrincipalCertificatesChanges(pointer.From(resp.Model.KeyCredentials), nil, managed, false)
+
+	properties := stable.ServicePrincipal{
+		KeyCredentials: &keyCredentials,
+	}
+	if resp, err := client.UpdateServicePrincipal(ctx, *servicePrincipalId, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing certificates from %s", servicePrincipalId)
+	}
+
+	if err = servicePrincipalCertificatesWait(ctx, client, *servicePrincipalId, nil, managed); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of certificates from %s", servicePrincipalId)
+	}
+
+	return nil
+}
+
+// servicePrincipalCertificatesApply replaces the key credentials of the service principal so that they reflect the
+// configuration. The caller is expected to hold the lock for the service principal.
+func servicePrincipalCertificatesApply(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, servicePrincipalId stable.ServicePrincipalId) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, servicePrincipalKeyCredentialsOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "service_principal_id", "%s was not found", servicePrincipalId)
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving %s", servicePrincipalId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	oldCertificates, newCertificates := d.GetChange("certificate")
+	managed := servicePrincipalCertificatesKeyIds(oldCertificates.(*pluginsdk.Set).List())
+
+	desired, err := expandServicePrincipalCertificates(newCertificates.(*pluginsdk.Set).List())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "certificate", "Could not expand certificates")
+	}
+
+	var diags pluginsdk.Diagnostics
+	if overlapping := servicePrincipalCertificatesOverlappingKeyIds(resp.Model.KeyCredentials, *desired, managed); len(overlapping) > 0 {
+		diags = append(diags, pluginsdk.Diagnostic{
+			Severity: pluginsdk.DiagWarning,
+			Summary:  fmt.Sprintf("%s already has key credential(s) with the same key ID as a certificate block", servicePrincipalId),
+			Detail: fmt.Sprintf("The key credentials [%s] were not previously managed by this resource and have been replaced by the "+
+				"configured certificates. If these are managed by an `azuread_service_principal_certificate` resource, or by `certificate` "+
+				"blocks of an `azuread_service_principal` resource, the resources will conflict.", strings.Join(overlapping, ", ")),
+			AttributePath: cty.GetAttrPath("certificate"),
+		})
+	}
+
+	keyCredentials := servicePrincipalCertificatesChanges(pointer.From(resp.Model.KeyCredentials), *desired, managed, d.Get("exclusive").(bool))
+
+	properties := stable.ServicePrincipal{
+		KeyCredentials: &keyCredentials,
+	}
+	if _, err = client.UpdateServicePrincipal(ctx, servicePrincipalId, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating certificates for %s", servicePrincipalId)
+	}
+
+	// Record any generated key IDs so that the certificates can be matched to their key credentials when reading
+	certificates := make([]interface{}, 0)
+	desiredKeyIds := make([]string, 0)
+	for _, raw := range newCertificates.(*pluginsdk.Set).List() {
+		if raw == nil {
+			continue
+		}
+		certificate := raw.(map[string]interface{})
+		certificate["key_id"] = (*desired)[len(certificates)].KeyId.GetOrZero()
+		certificates = append(certificates, certificate)
+		desiredKeyIds = append(desiredKeyIds, certificate["key_id"].(string))
+	}
+	tf.Set(d, "certificate", certificates)
+
+	removed := make([]string, 0)
+	for _, credential := range pointer.From(resp.Model.KeyCredentials) {
+		if keyId := credential.KeyId.GetOrZero(); credentials.GetKeyCredential(&keyCredentials, keyId) == nil {
+			removed = append(removed, keyId)
+		}
+	}
+
+	if err = servicePrincipalCertificatesWait(ctx, client, servicePrincipalId, desiredKeyIds, removed); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for certificate changes for %s", servicePrincipalId)
+	}
+
+	return diags
+}
+
+// servicePrincipalCertificatesWait waits for added key credentials to be present, and removed key credentials to be
+// absent, for the service principal
+func servicePrincipalCertificatesWait(ctx context.Context, client *serviceprincipal.ServicePrincipalClient, servicePrincipalId stable.ServicePrincipalId, added, removed []string) error {
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	return consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, servicePrincipalKeyCredentialsOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		if resp.Model == nil {
+			return pointer.To(false), nil
+		}
+
+		for _, keyId := range added {
+			if credentials.GetKeyCredential(resp.Model.KeyCredentials, keyId) == nil {
+				return pointer.To(false), nil
+			}
+		}
+		for _, keyId := range removed {
+			if credentials.GetKeyCredential(resp.Model.KeyCredentials, keyId) != nil {
+				return pointer.To(false), nil
+			}
+		}
+
+		return pointer.To(true), nil
+	})
+}
+
+// servicePrincipalCertificatesKeyIds returns the key IDs of the provided certificate blocks
+func servicePrincipalCertificatesKeyIds(in []interface{}) []string {
+	result := make([]string, 0)
+	for _, raw := range in {
+		if raw == nil {
+			continue
+		}
+		if keyId, ok := raw.(map[string]interface{})["key_id"].(string); ok && keyId != "" {
+			result = append(result, keyId)
+		}
+	}
+	return result
+}
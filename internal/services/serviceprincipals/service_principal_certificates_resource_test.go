@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ServicePrincipalCertificatesResource struct{}
+
+func TestAccServicePrincipalCertificates_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificates", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ServicePrincipalCertificatesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate.#").HasValue("1"),
+				check.That(data.ResourceName).Key("key_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccServicePrincipalCertificates_exclusive(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_certificates", "test")
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+	r := ServicePrincipalCertificatesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.exclusive(data, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate.#").HasValue("1"),
+				check.That(data.ResourceName).Key("key_ids.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.exclusiveEmpty(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate.#").HasValue("0"),
+				check.That(data.ResourceName).Key("key_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("exclusive"),
+	})
+}
+
+func (r ServicePrincipalCertificatesResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ServicePrincipals.ServicePrincipalClient
+
+	id, err := stable.ParseServicePrincipalID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetServicePrincipal(ctx, *id, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r ServicePrincipalCertificatesResource) basic(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificate" "other" {
+  service_principal_id = azuread_service_principal.test.id
+  type                 = "AsymmetricX509Cert"
+  end_date             = "%[2]s"
+  value                = <<EOT
+%[3]s
+EOT
+}
+
+resource "azuread_service_principal_certificates" "test" {
+  service_principal_id = azuread_service_principal.test.id
+
+  certificate {
+    type     = "AsymmetricX509Cert"
+    end_date = "%[2]s"
+    value    = <<EOT
+%[3]s
+EOT
+  }
+
+  depends_on = [azuread_service_principal_certificate.other]
+}
+`, ServicePrincipalCertificateResource{}.template(data), endDate, servicePrincipalCertificatePem)
+}
+
+func (r ServicePrincipalCertificatesResource) exclusive(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificates" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  exclusive            = true
+
+  certificate {
+    type     = "AsymmetricX509Cert"
+    end_date = "%[2]s"
+    value    = <<EOT
+%[3]s
+EOT
+  }
+}
+`, ServicePrincipalCertificateResource{}.template(data), endDate, servicePrincipalCertificatePem)
+}
+
+func (r ServicePrincipalCertificatesResource) exclusiveEmpty(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_certificates" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  exclusive            = true
+}
+`, ServicePrincipalCertificateResource{}.template(data))
+}
@@ -5,16 +5,15 @@ package serviceprincipals
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
-	"github.com/hashicorp/go-azure-helpers/lang/pointer"
-	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/claimsmappingpolicy"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/policyassignments"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/migrations"
@@ -85,16 +84,17 @@ func servicePrincipalClaimsMappingPolicyAssignmentResourceCreate(ctx context.Con
 		return tf.ErrorDiagPathF(err, "claims_mapping_policy_id", "Parsing `claims_mapping_policy_id`")
 	}
 
-	ref := stable.ReferenceCreate{
-		ODataId: pointer.To(client.Client.BaseUri + stable.NewDirectoryObjectID(policyId.ClaimsMappingPolicyId).ID()),
-	}
+	id := stable.NewServicePrincipalIdClaimsMappingPolicyID(servicePrincipalId.ServicePrincipalId, policyId.ClaimsMappingPolicyId)
 
-	if _, err := client.AddClaimsMappingPolicyRef(ctx, *servicePrincipalId, ref, claimsmappingpolicy.DefaultAddClaimsMappingPolicyRefOperationOptions()); err != nil {
-		return tf.ErrorDiagF(err, "Creating ClaimsMappingPolicyAssignment for %s", servicePrincipalId)
-	}
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
 
-	id := stable.NewServicePrincipalIdClaimsMappingPolicyID(servicePrincipalId.ServicePrincipalId, policyId.ClaimsMappingPolicyId)
-	d.SetId(id.ID())
+	if diags := servicePrincipalClaimsMappingPolicyAssignment(client, id).Create(ctx, d, "azuread_service_principal_claims_mapping_policy_assignment", client.Client.BaseUri, func(ctx context.Context, ref stable.ReferenceCreate) error {
+		_, err := client.AddClaimsMappingPolicyRef(ctx, *servicePrincipalId, ref, claimsmappingpolicy.DefaultAddClaimsMappingPolicyRefOperationOptions())
+		return err
+	}); diags.HasError() {
+		return diags
+	}
 
 	return servicePrincipalClaimsMappingPolicyAssignmentResourceRead(ctx, d, meta)
 }
@@ -110,34 +110,13 @@ func servicePrincipalClaimsMappingPolicyAssignmentResourceRead(ctx context.Conte
 	policyId := stable.NewPolicyClaimsMappingPolicyID(id.ClaimsMappingPolicyId)
 	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
 
-	resp, err := client.ListClaimsMappingPolicies(ctx, servicePrincipalId, claimsmappingpolicy.DefaultListClaimsMappingPoliciesOperationOptions())
+	exists, err := servicePrincipalClaimsMappingPolicyAssignment(client, *id).Exists(ctx)
 	if err != nil {
-		if response.WasNotFound(resp.HttpResponse) {
-			log.Printf("[DEBUG] %s was not found - removing claims mapping policy assignment from state!", servicePrincipalId)
-			d.SetId("")
-			return nil
-		}
-
 		return tf.ErrorDiagF(err, "listing Claims Mapping Policy Assignments for %s", servicePrincipalId)
 	}
-
-	policies := resp.Model
-	if policies == nil {
-		return tf.ErrorDiagF(errors.New("model was nil"), "listing Claims Mapping Policy Assignments for %s", servicePrincipalId)
-	}
-
-	var policy *stable.ClaimsMappingPolicy
-
-	// Check the assignment is found in the currently assigned policies
-	for _, p := range *policies {
-		if pointer.From(p.Id) == id.ClaimsMappingPolicyId {
-			policy = &p
-			break
-		}
-	}
-	if policy == nil {
+	if exists == nil || !*exists {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
 		d.SetId("")
-		log.Printf("[DEBUG] Claims Mapping Policy with Object ID %q was not found - removing assignment from state!", id.ClaimsMappingPolicyId)
 		return nil
 	}
 
@@ -155,9 +134,24 @@ func servicePrincipalClaimsMappingPolicyAssignmentResourceDelete(ctx context.Con
 		return tf.ErrorDiagPathF(err, "id", "Parsing Claims Mapping Policy Assignment ID %q", d.Id())
 	}
 
-	if _, err = client.RemoveClaimsMappingPolicyRef(ctx, *id, claimsmappingpolicy.DefaultRemoveClaimsMappingPolicyRefOperationOptions()); err != nil {
-		return tf.ErrorDiagF(err, "removing %s", id)
-	}
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
 
-	return servicePrincipalClaimsMappingPolicyAssignmentResourceRead(ctx, d, meta)
+	return servicePrincipalClaimsMappingPolicyAssignment(client, *id).Delete(ctx, func(ctx context.Context) (*http.Response, error) {
+		resp, err := client.RemoveClaimsMappingPolicyRef(ctx, *id, claimsmappingpolicy.DefaultRemoveClaimsMappingPolicyRefOperationOptions())
+		return resp.HttpResponse, err
+	})
+}
+
+// servicePrincipalClaimsMappingPolicyAssignment describes the assignment of a claims mapping policy to a service
+// principal
+func servicePrincipalClaimsMappingPolicyAssignment(client *claimsmappingpolicy.ClaimsMappingPolicyClient, id stable.ServicePrincipalIdClaimsMappingPolicyId) policyassignments.Assignment[stable.ClaimsMappingPolicy] {
+	return policyassignments.Assignment[stable.ClaimsMappingPolicy]{
+		ID:       &id,
+		PolicyId: id.ClaimsMappingPolicyId,
+		List: func(ctx context.Context) (*http.Response, *[]stable.ClaimsMappingPolicy, error) {
+			resp, err := client.ListClaimsMappingPolicies(ctx, stable.NewServicePrincipalID(id.ServicePrincipalId), claimsmappingpolicy.DefaultListClaimsMappingPoliciesOperationOptions())
+			return resp.HttpResponse, resp.Model, err
+		},
+	}
 }
@@ -328,23 +328,13 @@ func servicePrincipalDataSourceRead(ctx context.Context, d *pluginsdk.ResourceDa
 	} else {
 		clientId := d.Get("client_id").(string)
 
-		options := serviceprincipal.ListServicePrincipalsOperationOptions{
-			Filter: pointer.To(fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(clientId))),
-		}
-
-		resp, err := client.ListServicePrincipals(ctx, options)
+		var err error
+		servicePrincipal, err = servicePrincipalGetByClientId(ctx, client, clientId)
 		if err != nil {
-			return tf.ErrorDiagF(err, "Listing service principals for filter %q", *options.Filter)
-		}
-		if resp.Model == nil {
-			return tf.ErrorDiagF(errors.New("API returned nil resp.Model"), "Bad API Response")
+			return tf.ErrorDiagPathF(err, "client_id", "Retrieving service principal for application with client ID %q", clientId)
 		}
-
-		for _, sp := range *resp.Model {
-			if strings.EqualFold(sp.AppId.GetOrZero(), clientId) {
-				servicePrincipal = &sp
-				break
-			}
+		if servicePrincipal == nil {
+			return tf.ErrorDiagPathF(servicePrincipalNotFoundForClientIdError(clientId), "client_id", "Service principal not found")
 		}
 	}
 
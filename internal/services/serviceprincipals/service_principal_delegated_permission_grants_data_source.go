@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/oauth2permissiongrants/stable/oauth2permissiongrant"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func servicePrincipalDelegatedPermissionGrantsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: servicePrincipalDelegatedPermissionGrantsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_object_id": {
+				Description:  "The object ID of the service principal which is the client of the delegated permission grants",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"grants": {
+				Description: "A list of delegated permission grants where the service principal is the client",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The ID of the delegated permission grant",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"resource_service_principal_object_id": {
+							Description: "The object ID of the service principal representing the resource to be accessed",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"claim_values": {
+							Description: "A list of claim values for delegated permission scopes which are granted",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"consent_type": {
+							Description: "Whether the grant applies to all users (`AllPrincipals`) or to a single user (`Principal`)",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"user_object_id": {
+							Description: "The object ID of the user on behalf of whom the service principal is authorized, when the consent type is `Principal`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func servicePrincipalDelegatedPermissionGrantsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.OAuth2PermissionGrantClient
+	servicePrincipalClient := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	servicePrincipalId := stable.NewServicePrincipalID(d.Get("service_principal_object_id").(string))
+
+	if resp, err := servicePrincipalClient.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.GetServicePrincipalOperationOptions{Select: &[]string{"id"}}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "service_principal_object_id", "%s was not found", servicePrincipalId)
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_object_id", "Retrieving %s", servicePrincipalId)
+	}
+
+	options := oauth2permissiongrant.ListOAuth2PermissionGrantsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("clientId eq '%s'", servicePrincipalId.ServicePrincipalId)),
+	}
+	resp, err := client.ListOAuth2PermissionGrantsComplete(ctx, options)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing delegated permission grants for %s", servicePrincipalId)
+	}
+
+	grantIds := make([]string, 0)
+	grants := make([]map[string]interface{}, 0)
+	for _, grant := range resp.Items {
+		grantIds = append(grantIds, pointer.From(grant.Id))
+		grants = append(grants, map[string]interface{}{
+			"id":                                   pointer.From(grant.Id),
+			"resource_service_principal_object_id": pointer.From(grant.ResourceId),
+			"claim_values":                         tf.FromSpaceSeparated(grant.Scope.GetOrZero()),
+			"consent_type":                         grant.ConsentType.GetOrZero(),
+			"user_object_id":                       grant.PrincipalId.GetOrZero(),
+		})
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(servicePrincipalId.ServicePrincipalId + "/" + strings.Join(grantIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for delegated permission grant IDs")
+	}
+
+	d.SetId("delegatedPermissionGrants#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "grants", grants)
+
+	return nil
+}
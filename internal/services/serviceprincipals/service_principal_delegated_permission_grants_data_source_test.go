@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type ServicePrincipalDelegatedPermissionGrantsDataSource struct{}
+
+func TestAccServicePrincipalDelegatedPermissionGrantsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_service_principal_delegated_permission_grants", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: ServicePrincipalDelegatedPermissionGrantsDataSource{}.basic(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("grants.#").HasValue("1"),
+			check.That(data.ResourceName).Key("grants.0.id").Exists(),
+			check.That(data.ResourceName).Key("grants.0.resource_service_principal_object_id").IsUuid(),
+			check.That(data.ResourceName).Key("grants.0.claim_values.#").HasValue("2"),
+			check.That(data.ResourceName).Key("grants.0.consent_type").HasValue("AllPrincipals"),
+		),
+	}})
+}
+
+func (ServicePrincipalDelegatedPermissionGrantsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_service_principal_delegated_permission_grants" "test" {
+  service_principal_object_id = azuread_service_principal_delegated_permission_grant.test.service_principal_object_id
+}
+`, ServicePrincipalDelegatedPermissionGrantResource{}.allUsers(data))
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/federatedidentitycredential"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func servicePrincipalFederatedIdentityCredentialResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalFederatedIdentityCredentialResourceCreate,
+		UpdateContext: servicePrincipalFederatedIdentityCredentialResourceUpdate,
+		ReadContext:   servicePrincipalFederatedIdentityCredentialResourceRead,
+		DeleteContext: servicePrincipalFederatedIdentityCredentialResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(15 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := stable.ParseServicePrincipalIdFederatedIdentityCredentialID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The ID of the service principal for which this federated identity credential should be created",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"audiences": {
+				Description: "List of audiences that can appear in the external token. This specifies what should be accepted in the `aud` claim of incoming tokens.",
+				Type:        pluginsdk.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"display_name": {
+				Description:  "A unique display name for the federated identity credential",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 120),
+			},
+
+			"issuer": {
+				Description: "The URL of the external identity provider, which must match the issuer claim of the external token being exchanged. The combination of the values of issuer and subject must be unique on the service principal.",
+				Type:        pluginsdk.TypeString,
+				Required:    true,
+			},
+
+			"subject": {
+				Description: "The identifier of the external software workload within the external identity provider. The combination of issuer and subject must be unique on the service principal.",
+				Type:        pluginsdk.TypeString,
+				Required:    true,
+			},
+
+			"description": {
+				Description: "A description for the federated identity credential",
+				Type:        pluginsdk.TypeString,
+				Optional:    true,
+			},
+
+			"credential_id": {
+				Description: "A UUID used to uniquely identify this federated identity credential",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func servicePrincipalFederatedIdentityCredentialResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.FederatedIdentityCredentialClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	tf.LockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, servicePrincipalId.ServicePrincipalId)
+
+	credential := stable.FederatedIdentityCredential{
+		Audiences:   tf.ExpandStringSlice(d.Get("audiences").([]interface{})),
+		Description: nullable.Value(d.Get("description").(string)),
+		Issuer:      d.Get("issuer").(string),
+		Name:        d.Get("display_name").(string),
+		Subject:     d.Get("subject").(string),
+	}
+
+	resp, err := client.CreateFederatedIdentityCredential(ctx, *servicePrincipalId, credential, federatedidentitycredential.DefaultCreateFederatedIdentityCredentialOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "service_principal_id", "%s was not found", servicePrincipalId)
+		}
+		return tf.ErrorDiagF(err, "Adding federated identity credential for %s", servicePrincipalId)
+	}
+
+	newCredential := resp.Model
+	if newCredential == nil {
+		return tf.ErrorDiagF(errors.New("nil credential received when adding federated identity credential"), "API error adding federated identity credential for %s", servicePrincipalId)
+	}
+	if newCredential.Id == nil {
+		return tf.ErrorDiagF(errors.New("nil or empty ID received"), "API error adding federated identity credential for %s", servicePrincipalId)
+	}
+
+	id := stable.NewServicePrincipalIdFederatedIdentityCredentialID(servicePrincipalId.ServicePrincipalId, *newCredential.Id)
+
+	// Wait for the credential to replicate
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetFederatedIdentityCredential(ctx, id, federatedidentitycredential.DefaultGetFederatedIdentityCredentialOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for %s", id)
+	}
+
+	d.SetId(id.ID())
+
+	return servicePrincipalFederatedIdentityCredentialResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalFederatedIdentityCredentialResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.FederatedIdentityCredentialClient
+
+	id, err := stable.ParseServicePrincipalIdFederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing federated identity credential with ID %q", d.Id())
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	credential := stable.FederatedIdentityCredential{
+		Id:          pointer.To(id.FederatedIdentityCredentialId),
+		Audiences:   tf.ExpandStringSlice(d.Get("audiences").([]interface{})),
+		Description: nullable.Value(d.Get("description").(string)),
+		Issuer:      d.Get("issuer").(string),
+		Subject:     d.Get("subject").(string),
+
+		// Name is immutable but must be specified as it is a required field
+		Name: d.Get("display_name").(string),
+	}
+
+	if _, err = client.UpdateFederatedIdentityCredential(ctx, *id, credential, federatedidentitycredential.DefaultUpdateFederatedIdentityCredentialOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return servicePrincipalFederatedIdentityCredentialResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalFederatedIdentityCredentialResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.FederatedIdentityCredentialClient
+
+	id, err := stable.ParseServicePrincipalIdFederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing federated identity credential with ID %q", d.Id())
+	}
+
+	resp, err := client.GetFederatedIdentityCredential(ctx, *id, federatedidentitycredential.DefaultGetFederatedIdentityCredentialOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "id", "Retrieving %s", id)
+	}
+
+	credential := resp.Model
+	if credential == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "service_principal_id", stable.NewServicePrincipalID(id.ServicePrincipalId).ID())
+	tf.Set(d, "credential_id", id.FederatedIdentityCredentialId)
+
+	tf.Set(d, "audiences", tf.FlattenStringSlice(credential.Audiences))
+	tf.Set(d, "description", credential.Description.GetOrZero())
+	tf.Set(d, "display_name", credential.Name)
+	tf.Set(d, "issuer", credential.Issuer)
+	tf.Set(d, "subject", credential.Subject)
+
+	return nil
+}
+
+func servicePrincipalFederatedIdentityCredentialResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.FederatedIdentityCredentialClient
+
+	id, err := stable.ParseServicePrincipalIdFederatedIdentityCredentialID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing federated identity credential with ID %q", d.Id())
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	if resp, err := client.DeleteFederatedIdentityCredential(ctx, *id, federatedidentitycredential.DefaultDeleteFederatedIdentityCredentialOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing %s", id)
+	}
+
+	// Wait for credential to be deleted
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetFederatedIdentityCredential(ctx, *id, federatedidentitycredential.DefaultGetFederatedIdentityCredentialOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
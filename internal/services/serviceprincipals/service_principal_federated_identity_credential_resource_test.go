@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/federatedidentitycredential"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type ServicePrincipalFederatedIdentityCredentialResource struct{}
+
+func TestAccServicePrincipalFederatedIdentityCredential_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_federated_identity_credential", "test")
+	r := ServicePrincipalFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalFederatedIdentityCredential_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_federated_identity_credential", "test")
+	r := ServicePrincipalFederatedIdentityCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("credential_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServicePrincipalFederatedIdentityCredentialResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ServicePrincipals.FederatedIdentityCredentialClient
+
+	id, err := stable.ParseServicePrincipalIdFederatedIdentityCredentialID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Service Principal Federated Identity Credential ID: %v", err)
+	}
+
+	resp, err := client.GetFederatedIdentityCredential(ctx, *id, federatedidentitycredential.DefaultGetFederatedIdentityCredentialOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("failed to retrieve %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ServicePrincipalFederatedIdentityCredentialResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestFederatedIdentityCredential-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+}
+`, data.RandomInteger)
+}
+
+func (r ServicePrincipalFederatedIdentityCredentialResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_federated_identity_credential" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  display_name         = "hashitown-%[2]s"
+  audiences            = ["api://HashiTownLikesAzureAD"]
+  issuer               = "https://tokens.hashitown.net"
+  subject              = "%[3]s"
+}
+`, r.template(data), data.RandomString, data.RandomID)
+}
+
+func (r ServicePrincipalFederatedIdentityCredentialResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_federated_identity_credential" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  display_name         = "hashitown-%[2]s"
+  description          = "Funtime tokens for HashiTown"
+  audiences            = ["api://HashiTownLikesAzureAD"]
+  issuer               = "https://vending.hashitown.net"
+  subject              = "%[3]s"
+}
+`, r.template(data), data.RandomString, data.UUID())
+}
@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/homerealmdiscoverypolicy"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/policyassignments"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceCreate,
+		ReadContext:   servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceRead,
+		DeleteContext: servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := stable.ValidateServicePrincipalIdHomeRealmDiscoveryPolicyID(id, "id"); len(errs) > 0 {
+				out := ""
+				for _, err := range errs {
+					out += err.Error()
+				}
+				return fmt.Errorf(out)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"home_realm_discovery_policy_id": {
+				Description:  "ID of the home realm discovery policy to assign",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidatePolicyHomeRealmDiscoveryPolicyID,
+			},
+
+			"service_principal_id": {
+				Description:  "ID of the service principal for which to assign the policy",
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+		},
+	}
+}
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.HomeRealmDiscoveryPolicyClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	policyId, err := stable.ParsePolicyHomeRealmDiscoveryPolicyID(d.Get("home_realm_discovery_policy_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "home_realm_discovery_policy_id", "Parsing `home_realm_discovery_policy_id`")
+	}
+
+	id := stable.NewServicePrincipalIdHomeRealmDiscoveryPolicyID(servicePrincipalId.ServicePrincipalId, policyId.HomeRealmDiscoveryPolicyId)
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	if diags := servicePrincipalHomeRealmDiscoveryPolicyAssignment(client, id).Create(ctx, d, "azuread_service_principal_home_realm_discovery_policy_assignment", client.Client.BaseUri, func(ctx context.Context, ref stable.ReferenceCreate) error {
+		_, err := client.AddHomeRealmDiscoveryPolicyRef(ctx, *servicePrincipalId, ref, homerealmdiscoverypolicy.DefaultAddHomeRealmDiscoveryPolicyRefOperationOptions())
+		return err
+	}); diags.HasError() {
+		return diags
+	}
+
+	return servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParseServicePrincipalIdHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Home Realm Discovery Policy Assignment ID %q", d.Id())
+	}
+
+	policyId := stable.NewPolicyHomeRealmDiscoveryPolicyID(id.HomeRealmDiscoveryPolicyId)
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	exists, err := servicePrincipalHomeRealmDiscoveryPolicyAssignment(client, *id).Exists(ctx)
+	if err != nil {
+		return tf.ErrorDiagF(err, "listing Home Realm Discovery Policy Assignments for %s", servicePrincipalId)
+	}
+	if exists == nil || !*exists {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
+	tf.Set(d, "home_realm_discovery_policy_id", policyId.ID())
+
+	return nil
+}
+
+func servicePrincipalHomeRealmDiscoveryPolicyAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.HomeRealmDiscoveryPolicyClient
+
+	id, err := stable.ParseServicePrincipalIdHomeRealmDiscoveryPolicyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Home Realm Discovery Policy Assignment ID %q", d.Id())
+	}
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	return servicePrincipalHomeRealmDiscoveryPolicyAssignment(client, *id).Delete(ctx, func(ctx context.Context) (*http.Response, error) {
+		resp, err := client.RemoveHomeRealmDiscoveryPolicyRef(ctx, *id, homerealmdiscoverypolicy.DefaultRemoveHomeRealmDiscoveryPolicyRefOperationOptions())
+		return resp.HttpResponse, err
+	})
+}
+
+// servicePrincipalHomeRealmDiscoveryPolicyAssignment describes the assignment of a home realm discovery policy to a service
+// principal
+func servicePrincipalHomeRealmDiscoveryPolicyAssignment(client *homerealmdiscoverypolicy.HomeRealmDiscoveryPolicyClient, id stable.ServicePrincipalIdHomeRealmDiscoveryPolicyId) policyassignments.Assignment[stable.HomeRealmDiscoveryPolicy] {
+	return policyassignments.Assignment[stable.HomeRealmDiscoveryPolicy]{
+		ID:       &id,
+		PolicyId: id.HomeRealmDiscoveryPolicyId,
+		List: func(ctx context.Context) (*http.Response, *[]stable.HomeRealmDiscoveryPolicy, error) {
+			resp, err := client.ListHomeRealmDiscoveryPolicies(ctx, stable.NewServicePrincipalID(id.ServicePrincipalId), homerealmdiscoverypolicy.DefaultListHomeRealmDiscoveryPoliciesOperationOptions())
+			return resp.HttpResponse, resp.Model, err
+		},
+	}
+}
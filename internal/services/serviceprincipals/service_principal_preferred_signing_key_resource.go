@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+)
+
+func servicePrincipalPreferredSigningKeyResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: servicePrincipalPreferredSigningKeyResourceCreateUpdate,
+		ReadContext:   servicePrincipalPreferredSigningKeyResourceRead,
+		UpdateContext: servicePrincipalPreferredSigningKeyResourceCreateUpdate,
+		DeleteContext: servicePrincipalPreferredSigningKeyResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParsePreferredSigningKeyID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The ID of the service principal for which to set the preferred token signing key",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"thumbprint": {
+				Description:  "The thumbprint of the token signing certificate to use for signing SAML tokens",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9A-Fa-f]{40}$"), "must be a 40 character hexadecimal SHA-1 thumbprint"),
+			},
+		},
+	}
+}
+
+func servicePrincipalPreferredSigningKeyResourceCreateUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	id := parse.NewPreferredSigningKeyID(servicePrincipalId.ServicePrincipalId)
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	properties := stable.ServicePrincipal{
+		PreferredTokenSigningKeyThumbprint: nullable.Value(strings.ToUpper(d.Get("thumbprint").(string))),
+	}
+	if resp, err := client.UpdateServicePrincipal(ctx, *servicePrincipalId, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "service_principal_id", "%s was not found", servicePrincipalId)
+		}
+		return tf.ErrorDiagF(err, "Setting %s", id)
+	}
+
+	d.SetId(id.ID())
+
+	return servicePrincipalPreferredSigningKeyResourceRead(ctx, d, meta)
+}
+
+func servicePrincipalPreferredSigningKeyResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	id, err := parse.ParsePreferredSigningKeyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing preferred token signing key with ID %q", d.Id())
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	options := serviceprincipal.GetServicePrincipalOperationOptions{
+		Select: pointer.To([]string{"preferredTokenSigningKeyThumbprint"}),
+	}
+	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", servicePrincipalId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Retrieving %s", servicePrincipalId)
+	}
+
+	servicePrincipal := resp.Model
+	if servicePrincipal == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
+	}
+
+	thumbprint := servicePrincipal.PreferredTokenSigningKeyThumbprint.GetOrZero()
+	if thumbprint == "" {
+		log.Printf("[DEBUG] Preferred token signing key for %s was not set - removing from state!", servicePrincipalId)
+		d.SetId("")
+		return nil
+	}
+
+	// The API may return the thumbprint in a different case to that configured
+	if strings.EqualFold(thumbprint, d.Get("thumbprint").(string)) {
+		thumbprint = d.Get("thumbprint").(string)
+	}
+
+	tf.Set(d, "service_principal_id", servicePrincipalId.ID())
+	tf.Set(d, "thumbprint", thumbprint)
+
+	return nil
+}
+
+func servicePrincipalPreferredSigningKeyResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	id, err := parse.ParsePreferredSigningKeyID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing preferred token signing key with ID %q", d.Id())
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	tf.LockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+	defer tf.UnlockByName(servicePrincipalResourceName, id.ServicePrincipalId)
+
+	properties := stable.ServicePrincipal{}
+	properties.PreferredTokenSigningKeyThumbprint.SetNull()
+
+	if resp, err := client.UpdateServicePrincipal(ctx, servicePrincipalId, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Unsetting %s", id)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/serviceprincipals/parse"
+)
+
+type ServicePrincipalPreferredSigningKeyResource struct{}
+
+func TestAccServicePrincipalPreferredSigningKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_preferred_signing_key", "test")
+	r := ServicePrincipalPreferredSigningKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccServicePrincipalPreferredSigningKey_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal_preferred_signing_key", "test")
+	r := ServicePrincipalPreferredSigningKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.update(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("thumbprint").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ServicePrincipalPreferredSigningKeyResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.ServicePrincipals.ServicePrincipalClient
+
+	id, err := parse.ParsePreferredSigningKeyID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Preferred Token Signing Key ID: %v", err)
+	}
+
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	resp, err := client.GetServicePrincipal(ctx, servicePrincipalId, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s: %v", servicePrincipalId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: model was nil", servicePrincipalId)
+	}
+
+	return pointer.To(strings.EqualFold(resp.Model.PreferredTokenSigningKeyThumbprint.GetOrZero(), state.Attributes["thumbprint"])), nil
+}
+
+func (ServicePrincipalPreferredSigningKeyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id                     = azuread_application.test.client_id
+  preferred_single_sign_on_mode = "saml"
+}
+
+resource "azuread_service_principal_token_signing_certificate" "first" {
+  service_principal_id = azuread_service_principal.test.id
+  display_name         = "CN=acctestTokenSigningCert-first-%[2]s"
+}
+
+resource "azuread_service_principal_token_signing_certificate" "second" {
+  service_principal_id = azuread_service_principal.test.id
+  display_name         = "CN=acctestTokenSigningCert-second-%[2]s"
+
+  depends_on = [azuread_service_principal_token_signing_certificate.first]
+}
+`, data.RandomInteger, data.RandomID)
+}
+
+func (r ServicePrincipalPreferredSigningKeyResource) basic(data acceptance.TestData) string {
+	return r.update(data, "first")
+}
+
+func (r ServicePrincipalPreferredSigningKeyResource) update(data acceptance.TestData, certificate string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_service_principal_preferred_signing_key" "test" {
+  service_principal_id = azuread_service_principal.test.id
+  thumbprint           = azuread_service_principal_token_signing_certificate.%[2]s.thumbprint
+}
+`, r.template(data), certificate)
+}
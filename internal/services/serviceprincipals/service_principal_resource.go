@@ -99,6 +99,14 @@ func servicePrincipalResource() *pluginsdk.Resource {
 				Optional:    true,
 			},
 
+			"certificate": {
+				Description: "One or more certificates to associate with the service principal. When specified, these blocks manage the complete set of key credentials for the service principal",
+				Type:        pluginsdk.TypeSet,
+				Optional:    true,
+				Set:         servicePrincipalCertificateHash,
+				Elem:        schemaServicePrincipalCertificate(),
+			},
+
 			"description": {
 				Description:  "Description of the service principal provided for internal end-users",
 				Type:         pluginsdk.TypeString,
@@ -198,7 +206,7 @@ func servicePrincipalResource() *pluginsdk.Resource {
 				Optional:    true,
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
-					ValidateFunc: validation.StringIsNotEmpty,
+					ValidateFunc: validation.StringIsEmailAddress,
 				},
 			},
 
@@ -432,6 +440,15 @@ func servicePrincipalResourceCreate(ctx context.Context, d *pluginsdk.ResourceDa
 		Tags:                       &tags,
 	}
 
+	if v, ok := d.GetOk("certificate"); ok && v.(*pluginsdk.Set).Len() > 0 {
+		keyCredentials, err := expandServicePrincipalCertificates(v.(*pluginsdk.Set).List())
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "certificate", "Could not expand certificates")
+		}
+		properties.KeyCredentials = keyCredentials
+		tf.Set(d, "certificate", flattenServicePrincipalCertificates(v.(*pluginsdk.Set).List(), keyCredentials))
+	}
+
 	// Sort the owners into two slices, the first containing up to 20 and the rest overflowing to the second slice
 	// The calling principal should always be in the first slice of owners
 	ownersFirst20 := []string{fmt.Sprintf("%s%s", client.Client.BaseUri, stable.NewDirectoryObjectID(callerId).ID())}
@@ -509,6 +526,11 @@ func servicePrincipalResourceCreate(ctx context.Context, d *pluginsdk.ResourceDa
 		return tf.ErrorDiagF(err, "Failed to patch service principal after creating")
 	}
 
+	// Wait for any certificates to appear in the service principal manifest
+	if diags := servicePrincipalWaitForCertificates(ctx, client, id, properties.KeyCredentials); diags != nil {
+		return diags
+	}
+
 	// Add any remaining owners after the service principal is created
 	for _, ref := range ownersExtra {
 		if _, err = ownerClient.AddOwnerRef(ctx, id, ref, owner.DefaultAddOwnerRefOperationOptions()); err != nil {
@@ -558,10 +580,24 @@ func servicePrincipalResourceUpdate(ctx context.Context, d *pluginsdk.ResourceDa
 		Tags:                       &tags,
 	}
 
+	if d.HasChange("certificate") {
+		certificatesRaw := d.Get("certificate").(*pluginsdk.Set).List()
+		keyCredentials, err := expandServicePrincipalCertificates(certificatesRaw)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "certificate", "Could not expand certificates")
+		}
+		properties.KeyCredentials = keyCredentials
+		tf.Set(d, "certificate", flattenServicePrincipalCertificates(certificatesRaw, keyCredentials))
+	}
+
 	if _, err := client.UpdateServicePrincipal(ctx, *id, properties, serviceprincipal.DefaultUpdateServicePrincipalOperationOptions()); err != nil {
 		return tf.ErrorDiagF(err, "Updating %s", id)
 	}
 
+	if diags := servicePrincipalWaitForCertificates(ctx, client, *id, properties.KeyCredentials); diags != nil {
+		return diags
+	}
+
 	if d.HasChange("owners") {
 		resp, err := ownerClient.ListOwners(ctx, *id, owner.DefaultListOwnersOperationOptions())
 		if err != nil {
@@ -654,6 +690,7 @@ func servicePrincipalResourceRead(ctx context.Context, d *pluginsdk.ResourceData
 	tf.Set(d, "app_role_ids", applications.FlattenAppRoleIDs(servicePrincipal.AppRoles))
 	tf.Set(d, "app_roles", applications.FlattenAppRoles(servicePrincipal.AppRoles))
 	tf.Set(d, "application_tenant_id", servicePrincipal.AppOwnerOrganizationId.GetOrZero())
+	tf.Set(d, "certificate", flattenServicePrincipalCertificates(d.Get("certificate").(*pluginsdk.Set).List(), servicePrincipal.KeyCredentials))
 	tf.Set(d, "client_id", servicePrincipal.AppId.GetOrZero())
 	tf.Set(d, "description", servicePrincipal.Description.GetOrZero())
 	tf.Set(d, "display_name", servicePrincipal.DisplayName.GetOrZero())
@@ -720,3 +757,20 @@ func servicePrincipalResourceDelete(ctx context.Context, d *pluginsdk.ResourceDa
 
 	return nil
 }
+
+// servicePrincipalWaitForCertificates waits for the provided key credentials to appear in the service principal manifest
+func servicePrincipalWaitForCertificates(ctx context.Context, client *serviceprincipal.ServicePrincipalClient, id stable.ServicePrincipalId, keyCredentials *[]stable.KeyCredential) pluginsdk.Diagnostics {
+	if keyCredentials == nil {
+		return nil
+	}
+
+	for _, cred := range *keyCredentials {
+		if err := consistency.WaitForUpdate(ctx, servicePrincipalKeyCredentialExists(func(ctx context.Context) (serviceprincipal.GetServicePrincipalOperationResponse, error) {
+			return client.GetServicePrincipal(ctx, id, serviceprincipal.DefaultGetServicePrincipalOperationOptions())
+		}, cred.KeyId.GetOrZero())); err != nil {
+			return tf.ErrorDiagF(err, "Waiting for certificate credential %q for %s", cred.KeyId.GetOrZero(), id)
+		}
+	}
+
+	return nil
+}
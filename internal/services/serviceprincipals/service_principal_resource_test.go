@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -53,6 +55,7 @@ func TestAccServicePrincipal_complete(t *testing.T) {
 				check.That(data.ResourceName).Key("application_tenant_id").HasValue(tenantId),
 				check.That(data.ResourceName).Key("homepage_url").HasValue(fmt.Sprintf("https://test-%d.internal", data.RandomInteger)),
 				check.That(data.ResourceName).Key("logout_url").HasValue(fmt.Sprintf("https://test-%d.internal/logout", data.RandomInteger)),
+				check.That(data.ResourceName).Key("notification_email_addresses.#").HasValue("2"),
 				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
 				check.That(data.ResourceName).Key("oauth2_permission_scopes.#").HasValue("2"),
 				check.That(data.ResourceName).Key("service_principal_names.#").HasValue("2"),
@@ -89,6 +92,7 @@ func TestAccServicePrincipal_completeUpdate(t *testing.T) {
 				check.That(data.ResourceName).Key("app_role_ids.%").HasValue("2"),
 				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("2"),
 				check.That(data.ResourceName).Key("oauth2_permission_scopes.#").HasValue("2"),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.relay_state").HasValue("/samlHome"),
 			),
 		},
 		data.ImportStep("use_existing"),
@@ -100,6 +104,7 @@ func TestAccServicePrincipal_completeUpdate(t *testing.T) {
 				check.That(data.ResourceName).Key("app_role_ids.%").HasValue("0"),
 				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.%").HasValue("0"),
 				check.That(data.ResourceName).Key("oauth2_permission_scopes.#").HasValue("0"),
+				check.That(data.ResourceName).Key("saml_single_sign_on.0.relay_state").IsEmpty(),
 			),
 		},
 		data.ImportStep("use_existing"),
@@ -192,6 +197,43 @@ func TestAccServicePrincipal_featureTagsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_invalidNotificationEmailAddress(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidNotificationEmailAddress(data),
+			ExpectError: regexp.MustCompile("value must be a valid email address"),
+		},
+	})
+}
+
+func TestAccServicePrincipal_linkedSingleSignOn(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkedSingleSignOn(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("login_url").HasValue("https://linked.hashitown.example.com/"),
+				check.That(data.ResourceName).Key("preferred_single_sign_on_mode").HasValue("linked"),
+			),
+		},
+		data.ImportStep("use_existing"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("preferred_single_sign_on_mode").HasValue(""),
+			),
+		},
+		data.ImportStep("use_existing"),
+	})
+}
+
 func TestAccServicePrincipal_owners(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
 	r := ServicePrincipalResource{}
@@ -314,6 +356,35 @@ func TestAccServicePrincipal_fromApplicationTemplate(t *testing.T) {
 	})
 }
 
+func TestAccServicePrincipal_certificates(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_service_principal", "test")
+	r := ServicePrincipalResource{}
+	endDate := time.Now().AddDate(0, 3, 27).UTC().Format(time.RFC3339)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.certificate(data, endDate),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate.#").HasValue("1"),
+			),
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("certificate.#").HasValue("0"),
+			),
+		},
+	})
+}
+
 func (r ServicePrincipalResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.ServicePrincipals.ServicePrincipalClient
 
@@ -347,6 +418,28 @@ resource "azuread_service_principal" "test" {
 `, data.RandomInteger)
 }
 
+func (ServicePrincipalResource) certificate(data acceptance.TestData, endDate string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id = azuread_application.test.client_id
+
+  certificate {
+    type     = "AsymmetricX509Cert"
+    end_date = "%[2]s"
+    value    = <<EOT
+%[3]s
+EOT
+  }
+}
+`, data.RandomInteger, endDate, servicePrincipalCertificatePem)
+}
+
 func (ServicePrincipalResource) templateComplete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
@@ -513,6 +606,37 @@ resource "azuread_service_principal" "test" {
 `, r.templateComplete(data), data.RandomInteger)
 }
 
+func (ServicePrincipalResource) invalidNotificationEmailAddress(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id                    = azuread_application.test.client_id
+  notification_email_addresses = ["not-an-email-address"]
+}
+`, data.RandomInteger)
+}
+
+func (ServicePrincipalResource) linkedSingleSignOn(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_application" "test" {
+  display_name = "acctestServicePrincipal-%[1]d"
+}
+
+resource "azuread_service_principal" "test" {
+  client_id                     = azuread_application.test.client_id
+  login_url                     = "https://linked.hashitown.example.com/"
+  preferred_single_sign_on_mode = "linked"
+}
+`, data.RandomInteger)
+}
+
 func (ServicePrincipalResource) templateThreeUsers(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azuread" {}
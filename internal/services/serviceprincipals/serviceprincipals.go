@@ -4,19 +4,33 @@
 package serviceprincipals
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/owner"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/credentials"
 )
 
 func expandSamlSingleSignOn(in []interface{}) *stable.SamlSingleSignOnSettings {
 	result := stable.SamlSingleSignOnSettings{}
 	if len(in) == 0 || in[0] == nil {
+		// Explicitly unset the relay state, so that it is cleared when the block is removed
+		result.RelayState.SetNull()
 		return &result
 	}
 
 	samlSingleSignOnSettings := in[0].(map[string]interface{})
 
-	result.RelayState = nullable.Value(samlSingleSignOnSettings["relay_state"].(string))
+	result.RelayState = nullable.NoZero(samlSingleSignOnSettings["relay_state"].(string))
 
 	return &result
 }
@@ -30,3 +44,187 @@ func flattenSamlSingleSignOn(in *stable.SamlSingleSignOnSettings) []map[string]i
 		"relay_state": in.RelayState.GetOrZero(),
 	}}
 }
+
+func expandServicePrincipalCertificates(in []interface{}) (*[]stable.KeyCredential, error) {
+	result := make([]stable.KeyCredential, 0)
+
+	for i, raw := range in {
+		if raw == nil {
+			continue
+		}
+
+		credential, err := credentials.KeyCredential(raw.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("certificate block %d: %+v", i, err)
+		}
+		result = append(result, *credential)
+	}
+
+	return &result, nil
+}
+
+// flattenServicePrincipalCertificates returns the configured certificate blocks which are still present in the provided
+// key credentials. The certificate data is not returned by the API so is retained from the configured blocks, and any
+// key credentials not associated with a configured block are omitted.
+func flattenServicePrincipalCertificates(configured []interface{}, keyCredentials *[]stable.KeyCredential) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if keyCredentials == nil {
+		return result
+	}
+
+	for _, raw := range configured {
+		if raw == nil {
+			continue
+		}
+		certificate := raw.(map[string]interface{})
+
+		credential := credentials.GetKeyCredential(keyCredentials, certificate["key_id"].(string))
+		if credential == nil {
+			continue
+		}
+
+		result = append(result, map[string]interface{}{
+			"key_id":     credential.KeyId.GetOrZero(),
+			"type":       credential.Type.GetOrZero(),
+			"encoding":   certificate["encoding"],
+			"value":      certificate["value"],
+			"start_date": credential.StartDateTime.GetOrZero(),
+			"end_date":   credential.EndDateTime.GetOrZero(),
+		})
+	}
+
+	return result
+}
+
+// servicePrincipalCertificatesChanges returns the complete set of key credentials that should be assigned to a service
+// principal, comprising the desired credentials along with any existing credentials that should be retained. When
+// exclusive is false, only those existing credentials which were previously managed are removed, so that credentials
+// added outside of Terraform, or by other resources, are retained.
+func servicePrincipalCertificatesChanges(existing, desired []stable.KeyCredential, managed []string, exclusive bool) []stable.KeyCredential {
+	result := make([]stable.KeyCredential, 0)
+
+	if !exclusive {
+		for _, credential := range existing {
+			keyId := credential.KeyId.GetOrZero()
+			if credentials.GetKeyCredential(&desired, keyId) != nil {
+				continue
+			}
+			if servicePrincipalKeyIdInSlice(managed, keyId) {
+				continue
+			}
+			result = append(result, credential)
+		}
+	}
+
+	return append(result, desired...)
+}
+
+// servicePrincipalCertificatesOverlappingKeyIds returns the key IDs of desired credentials which already exist for the
+// service principal but are not present in `managed`, indicating that they were added outside of Terraform or by another
+// resource
+func servicePrincipalCertificatesOverlappingKeyIds(existing *[]stable.KeyCredential, desired []stable.KeyCredential, managed []string) []string {
+	result := make([]string, 0)
+	for _, credential := range desired {
+		keyId := credential.KeyId.GetOrZero()
+		if credentials.GetKeyCredential(existing, keyId) != nil && !servicePrincipalKeyIdInSlice(managed, keyId) {
+			result = append(result, keyId)
+		}
+	}
+	return result
+}
+
+// servicePrincipalUnmanagedKeyIds returns the key IDs of existing credentials which are not present in `managed`
+func servicePrincipalUnmanagedKeyIds(existing *[]stable.KeyCredential, managed []string) []string {
+	result := make([]string, 0)
+	for _, credential := range pointer.From(existing) {
+		if keyId := credential.KeyId.GetOrZero(); !servicePrincipalKeyIdInSlice(managed, keyId) {
+			result = append(result, keyId)
+		}
+	}
+	return result
+}
+
+func servicePrincipalKeyIdInSlice(keyIds []string, keyId string) bool {
+	for _, v := range keyIds {
+		if strings.EqualFold(v, keyId) {
+			return true
+		}
+	}
+	return false
+}
+
+// servicePrincipalGetOwner returns the owner of a service principal identified by `id`, or nil if the principal is
+// not an owner of the service principal
+func servicePrincipalGetOwner(ctx context.Context, client *owner.OwnerClient, id stable.ServicePrincipalIdOwnerId) (stable.DirectoryObject, error) {
+	servicePrincipalId := stable.NewServicePrincipalID(id.ServicePrincipalId)
+
+	options := owner.ListOwnersOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("id eq '%s'", id.DirectoryObjectId)),
+	}
+
+	resp, err := client.ListOwners(ctx, servicePrincipalId, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to list Owners with filter %q: %+v", *options.Filter, err)
+	}
+
+	if resp.Model != nil {
+		for _, o := range *resp.Model {
+			if o.DirectoryObject().Id != nil && strings.EqualFold(*o.DirectoryObject().Id, id.DirectoryObjectId) {
+				return o, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// servicePrincipalGetByClientId returns the service principal for the application with the provided client ID, or
+// nil when the application has no service principal in the tenant. The client ID is matched case-insensitively.
+func servicePrincipalGetByClientId(ctx context.Context, client *serviceprincipal.ServicePrincipalClient, clientId string) (*stable.ServicePrincipal, error) {
+	options := serviceprincipal.ListServicePrincipalsOperationOptions{
+		Filter: pointer.To(fmt.Sprintf("appId eq '%s'", odata.EscapeSingleQuote(strings.ToLower(clientId)))),
+	}
+
+	resp, err := client.ListServicePrincipals(ctx, options)
+	if err != nil {
+		return nil, fmt.Errorf("listing service principals for filter %q: %+v", *options.Filter, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("listing service principals for filter %q: model was nil", *options.Filter)
+	}
+
+	for _, sp := range *resp.Model {
+		if strings.EqualFold(sp.AppId.GetOrZero(), clientId) {
+			return &sp, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// servicePrincipalNotFoundForClientIdError returns an error describing a missing service principal for the provided
+// client ID. Microsoft first-party applications do not always have a service principal in every tenant, so the error
+// names the well-known application where possible and suggests how to create its service principal.
+func servicePrincipalNotFoundForClientIdError(clientId string) error {
+	// Names are checked in sorted order so that the error is deterministic, should more than one name match
+	for _, name := range wellKnownServicePrincipalNames() {
+		if strings.EqualFold(environments.PublishedApis[name], clientId) {
+			return fmt.Errorf("no service principal was found for the well-known application %q (client ID %q). A service principal for this application may not exist in the tenant, in which case it can be created using the `azuread_service_principal` resource with `use_existing = true`", name, clientId)
+		}
+	}
+
+	return fmt.Errorf("no service principal was found for the application with client ID %q", clientId)
+}
+
+// wellKnownServicePrincipalNames returns the sorted names of well-known applications published by Microsoft
+func wellKnownServicePrincipalNames() []string {
+	names := make([]string, 0, len(environments.PublishedApis))
+	for name := range environments.PublishedApis {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+)
+
+func TestExpandSamlSingleSignOn(t *testing.T) {
+	cases := []struct {
+		TestName string
+		Input    []interface{}
+		Expected string
+	}{
+		{
+			TestName: "NoBlock",
+			Input:    []interface{}{},
+			Expected: `{"relayState":null}`,
+		},
+		{
+			TestName: "EmptyBlock",
+			Input:    []interface{}{nil},
+			Expected: `{"relayState":null}`,
+		},
+		{
+			TestName: "EmptyRelayState",
+			Input:    []interface{}{map[string]interface{}{"relay_state": ""}},
+			Expected: `{"relayState":null}`,
+		},
+		{
+			TestName: "RelayState",
+			Input:    []interface{}{map[string]interface{}{"relay_state": "/samlHome"}},
+			Expected: `{"relayState":"/samlHome"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			out, err := json.Marshal(expandSamlSingleSignOn(tc.Input))
+			if err != nil {
+				t.Fatalf("marshaling SAML single sign-on settings: %v", err)
+			}
+			if string(out) != tc.Expected {
+				t.Fatalf("expected %s, got %s", tc.Expected, out)
+			}
+		})
+	}
+}
+
+func TestServicePrincipalCertificatesChanges(t *testing.T) {
+	keyCredentials := func(keyIds ...string) []stable.KeyCredential {
+		result := make([]stable.KeyCredential, 0)
+		for _, keyId := range keyIds {
+			result = append(result, stable.KeyCredential{KeyId: nullable.Value(keyId)})
+		}
+		return result
+	}
+
+	cases := []struct {
+		TestName  string
+		Existing  []stable.KeyCredential
+		Desired   []stable.KeyCredential
+		Managed   []string
+		Exclusive bool
+		Expected  []string
+	}{
+		{
+			TestName: "RetainsUnmanaged",
+			Existing: keyCredentials("a", "b"),
+			Desired:  keyCredentials("c"),
+			Expected: []string{"a", "b", "c"},
+		},
+		{
+			TestName: "RemovesManaged",
+			Existing: keyCredentials("a", "b"),
+			Desired:  keyCredentials("c"),
+			Managed:  []string{"b"},
+			Expected: []string{"a", "c"},
+		},
+		{
+			TestName: "ReplacesDesired",
+			Existing: keyCredentials("a", "b"),
+			Desired:  keyCredentials("b"),
+			Managed:  []string{"b"},
+			Expected: []string{"a", "b"},
+		},
+		{
+			TestName:  "ExclusiveRemovesUnmanaged",
+			Existing:  keyCredentials("a", "b"),
+			Desired:   keyCredentials("c"),
+			Exclusive: true,
+			Expected:  []string{"c"},
+		},
+		{
+			TestName:  "ExclusiveRemovesAll",
+			Existing:  keyCredentials("a", "b"),
+			Managed:   []string{"a"},
+			Exclusive: true,
+			Expected:  []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			keyIds := make([]string, 0)
+			for _, credential := range servicePrincipalCertificatesChanges(tc.Existing, tc.Desired, tc.Managed, tc.Exclusive) {
+				keyIds = append(keyIds, credential.KeyId.GetOrZero())
+			}
+			if !reflect.DeepEqual(keyIds, tc.Expected) {
+				t.Fatalf("expected %v, got %v", tc.Expected, keyIds)
+			}
+		})
+	}
+}
+
+func TestServicePrincipalNotFoundForClientIdError(t *testing.T) {
+	names := wellKnownServicePrincipalNames()
+	if !slices.Contains(names, "MicrosoftGraph") {
+		t.Fatalf("expected well-known names to include MicrosoftGraph, got %v", names)
+	}
+	if !slices.IsSorted(names) {
+		t.Fatalf("expected well-known names to be sorted, got %v", names)
+	}
+
+	err := servicePrincipalNotFoundForClientIdError("00000003-0000-0000-C000-000000000000")
+	if !strings.Contains(err.Error(), `well-known application "MicrosoftGraph"`) {
+		t.Fatalf("expected error to name the well-known application, got: %v", err)
+	}
+	for i := 0; i < 10; i++ {
+		if other := servicePrincipalNotFoundForClientIdError("00000003-0000-0000-C000-000000000000"); other.Error() != err.Error() {
+			t.Fatalf("expected error to be deterministic, got %q and %q", err, other)
+		}
+	}
+
+	err = servicePrincipalNotFoundForClientIdError("11111111-1111-1111-1111-111111111111")
+	if strings.Contains(err.Error(), "well-known") {
+		t.Fatalf("expected error not to reference a well-known application, got: %v", err)
+	}
+}
+
+func TestServicePrincipalCertificateHash(t *testing.T) {
+	certificate := map[string]interface{}{
+		"type":     "AsymmetricX509Cert",
+		"encoding": "pem",
+		"value":    "certificate-data",
+	}
+
+	// Computed properties must not change the hash, otherwise they would not be associated with the configured block
+	withComputed := map[string]interface{}{
+		"key_id":     "00000000-0000-0000-0000-000000000001",
+		"start_date": "2024-01-01T00:00:00Z",
+		"end_date":   "2025-01-01T00:00:00Z",
+	}
+	for k, v := range certificate {
+		withComputed[k] = v
+	}
+	if servicePrincipalCertificateHash(certificate) != servicePrincipalCertificateHash(withComputed) {
+		t.Fatalf("expected hash to ignore key_id, start_date and end_date")
+	}
+
+	for _, k := range []string{"type", "encoding", "value"} {
+		changed := map[string]interface{}{}
+		for key, v := range certificate {
+			changed[key] = v
+		}
+		changed[k] = "changed"
+		if servicePrincipalCertificateHash(certificate) == servicePrincipalCertificateHash(changed) {
+			t.Fatalf("expected hash to change when %q changes", k)
+		}
+	}
+}
+
+func TestServicePrincipalCertificatesOverlappingKeyIds(t *testing.T) {
+	keyCredentials := func(keyIds ...string) []stable.KeyCredential {
+		result := make([]stable.KeyCredential, 0)
+		for _, keyId := range keyIds {
+			result = append(result, stable.KeyCredential{KeyId: nullable.Value(keyId)})
+		}
+		return result
+	}
+
+	cases := []struct {
+		TestName string
+		Existing []stable.KeyCredential
+		Desired  []stable.KeyCredential
+		Managed  []string
+		Expected []string
+	}{
+		{
+			TestName: "NoOverlap",
+			Existing: keyCredentials("a", "b"),
+			Desired:  keyCredentials("c"),
+			Expected: []string{},
+		},
+		{
+			TestName: "OverlapsUnmanaged",
+			Existing: keyCredentials("a", "b"),
+			Desired:  keyCredentials("b", "c"),
+			Expected: []string{"b"},
+		},
+		{
+			TestName: "IgnoresManaged",
+			Existing: keyCredentials("a", "b"),
+			Desired:  keyCredentials("b"),
+			Managed:  []string{"b"},
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			if actual := servicePrincipalCertificatesOverlappingKeyIds(&tc.Existing, tc.Desired, tc.Managed); !reflect.DeepEqual(actual, tc.Expected) {
+				t.Fatalf("expected %v, got %v", tc.Expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/applications"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func wellKnownServicePrincipalDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: wellKnownServicePrincipalDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Description:  "The name of the well-known application published by Microsoft, for example `MicrosoftGraph`",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(wellKnownServicePrincipalNames(), false),
+			},
+
+			"app_role_ids": {
+				Description: "Mapping of app role names to UUIDs",
+				Type:        pluginsdk.TypeMap,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"client_id": {
+				Description: "The client ID of the well-known application",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"display_name": {
+				Description: "The display name of the service principal",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"oauth2_permission_scope_ids": {
+				Description: "Mapping of OAuth2.0 permission scope names to UUIDs",
+				Type:        pluginsdk.TypeMap,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"object_id": {
+				Description: "The object ID of the service principal",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func wellKnownServicePrincipalDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).ServicePrincipals.ServicePrincipalClient
+
+	name := d.Get("name").(string)
+	clientId, ok := environments.PublishedApis[name]
+	if !ok {
+		return tf.ErrorDiagPathF(nil, "name", "Unknown well-known application %q", name)
+	}
+
+	servicePrincipal, err := servicePrincipalGetByClientId(ctx, client, clientId)
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "name", "Retrieving service principal for well-known application %q", name)
+	}
+	if servicePrincipal == nil {
+		return tf.ErrorDiagPathF(servicePrincipalNotFoundForClientIdError(clientId), "name", "Service principal not found")
+	}
+	if servicePrincipal.Id == nil {
+		return tf.ErrorDiagF(errors.New("API returned service principal with nil object ID"), "Bad API Response")
+	}
+
+	id := stable.NewServicePrincipalID(*servicePrincipal.Id)
+	d.SetId(id.ID())
+
+	tf.Set(d, "app_role_ids", applications.FlattenAppRoleIDs(servicePrincipal.AppRoles))
+	tf.Set(d, "client_id", servicePrincipal.AppId.GetOrZero())
+	tf.Set(d, "display_name", servicePrincipal.DisplayName.GetOrZero())
+	tf.Set(d, "oauth2_permission_scope_ids", applications.FlattenOAuth2PermissionScopeIDs(servicePrincipal.OAuth2PermissionScopes))
+	tf.Set(d, "object_id", *servicePrincipal.Id)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package serviceprincipals_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type WellKnownServicePrincipalDataSource struct{}
+
+func TestAccWellKnownServicePrincipalDataSource_microsoftGraph(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_well_known_service_principal", "test")
+	r := WellKnownServicePrincipalDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.microsoftGraph(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("client_id").HasValue(environments.PublishedApis["MicrosoftGraph"]),
+				check.That(data.ResourceName).Key("object_id").IsUuid(),
+				check.That(data.ResourceName).Key("display_name").Exists(),
+				check.That(data.ResourceName).Key("app_role_ids.User.Read.All").IsUuid(),
+				check.That(data.ResourceName).Key("oauth2_permission_scope_ids.User.Read").IsUuid(),
+			),
+		},
+	})
+}
+
+func TestAccWellKnownServicePrincipalDataSource_unknownName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_well_known_service_principal", "test")
+	r := WellKnownServicePrincipalDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config:      r.unknownName(),
+			ExpectError: regexp.MustCompile("expected name to be one of"),
+		},
+	})
+}
+
+func (WellKnownServicePrincipalDataSource) microsoftGraph() string {
+	return `
+data "azuread_well_known_service_principal" "test" {
+  name = "MicrosoftGraph"
+}
+`
+}
+
+func (WellKnownServicePrincipalDataSource) unknownName() string {
+	return `
+data "azuread_well_known_service_principal" "test" {
+  name = "NotAWellKnownApplication"
+}
+`
+}
@@ -7,13 +7,15 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationsecret"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationtemplate"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
 )
 
 type Client struct {
-	ServicePrincipalClient      *serviceprincipal.ServicePrincipalClient
-	SynchronizationJobClient    *synchronizationjob.SynchronizationJobClient
-	SynchronizationSecretClient *synchronizationsecret.SynchronizationSecretClient
+	ServicePrincipalClient        *serviceprincipal.ServicePrincipalClient
+	SynchronizationJobClient      *synchronizationjob.SynchronizationJobClient
+	SynchronizationSecretClient   *synchronizationsecret.SynchronizationSecretClient
+	SynchronizationTemplateClient *synchronizationtemplate.SynchronizationTemplateClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -35,9 +37,16 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(synchronizationSecretClient.Client)
 
+	synchronizationTemplateClient, err := synchronizationtemplate.NewSynchronizationTemplateClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(synchronizationTemplateClient.Client)
+
 	return &Client{
-		ServicePrincipalClient:      servicePrincipalClient,
-		SynchronizationJobClient:    synchronizationJobClient,
-		SynchronizationSecretClient: synchronizationSecretClient,
+		ServicePrincipalClient:        servicePrincipalClient,
+		SynchronizationJobClient:      synchronizationJobClient,
+		SynchronizationSecretClient:   synchronizationSecretClient,
+		SynchronizationTemplateClient: synchronizationTemplateClient,
 	}, nil
 }
@@ -29,7 +29,10 @@ func (r Registration) WebsiteCategories() []string {
 
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
-	return map[string]*pluginsdk.Resource{}
+	return map[string]*pluginsdk.Resource{
+		"azuread_synchronization_job":  synchronizationJobDataSource(),
+		"azuread_synchronization_jobs": synchronizationJobsDataSource(),
+	}
 }
 
 // SupportedResources returns the supported Resources supported by this Service
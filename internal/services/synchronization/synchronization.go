@@ -4,7 +4,12 @@
 package synchronization
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -12,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
 )
 
 const servicePrincipalResourceName = "azuread_service_principal"
@@ -22,6 +28,68 @@ func synchronizationRetryFunc() client.RequestRetryFunc {
 	}
 }
 
+// synchronizationJobRetryMinTimeout is the minimum interval between attempts in synchronizationJobRetryOnNotFound
+var synchronizationJobRetryMinTimeout = 5 * time.Second
+
+// synchronizationJobRetryOnNotFound invokes f until it no longer returns a NotFound response, or until the context
+// deadline is reached. This is used for actions on newly created synchronization jobs, which may not be immediately
+// available even after they can be retrieved.
+func synchronizationJobRetryOnNotFound(ctx context.Context, f func(ctx context.Context) (*http.Response, error)) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return errors.New("context has no deadline")
+	}
+
+	_, err := (&pluginsdk.StateChangeConf{ //nolint:staticcheck
+		Pending:    []string{"NotFound"},
+		Target:     []string{"Done"},
+		Timeout:    time.Until(deadline),
+		MinTimeout: synchronizationJobRetryMinTimeout,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := f(ctx)
+			if err != nil {
+				if response.WasNotFound(resp) {
+					return "stub", "NotFound", nil
+				}
+				return nil, "Error", err
+			}
+			return "stub", "Done", nil
+		},
+	}).WaitForStateContext(ctx)
+
+	return err
+}
+
+// synchronizationTemplateIdForFactoryTag returns the ID of the synchronization template having the specified factory
+// tag. Where more than one template matches, the default template is preferred.
+func synchronizationTemplateIdForFactoryTag(templates *[]stable.SynchronizationTemplate, factoryTag string) (string, error) {
+	matches := make([]stable.SynchronizationTemplate, 0)
+	if templates != nil {
+		for _, template := range *templates {
+			if strings.EqualFold(template.FactoryTag.GetOrZero(), factoryTag) {
+				matches = append(matches, template)
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no synchronization template was found with factory tag %q", factoryTag)
+	case 1:
+		return pointer.From(matches[0].Id), nil
+	}
+
+	ids := make([]string, 0, len(matches))
+	for _, template := range matches {
+		if pointer.From(template.Default) {
+			return pointer.From(template.Id), nil
+		}
+		ids = append(ids, pointer.From(template.Id))
+	}
+
+	return "", fmt.Errorf("more than one synchronization template was found with factory tag %q, specify `template_id` instead: %s", factoryTag, strings.Join(ids, ", "))
+}
+
 func emptySynchronizationSecretKeyStringValuePair(in []interface{}) *[]stable.SynchronizationSecretKeyStringValuePair {
 	result := make([]stable.SynchronizationSecretKeyStringValuePair, 0)
 
@@ -93,6 +161,25 @@ func expandSynchronizationJobSubject(in []interface{}) *[]stable.Synchronization
 	return &result
 }
 
+// expandSynchronizationSchedule returns the writable schedule properties, or nil when no interval has been specified.
+// The schedule state is managed using the start and pause actions.
+func expandSynchronizationSchedule(in []interface{}) *stable.SynchronizationSchedule {
+	if len(in) == 0 || in[0] == nil {
+		return nil
+	}
+
+	schedule := in[0].(map[string]interface{})
+
+	interval := schedule["interval"].(string)
+	if interval == "" {
+		return nil
+	}
+
+	return &stable.SynchronizationSchedule{
+		Interval: pointer.To(interval),
+	}
+}
+
 func flattenSynchronizationSchedule(in *stable.SynchronizationSchedule) []map[string]interface{} {
 	if in == nil {
 		return []map[string]interface{}{}
@@ -105,6 +192,43 @@ func flattenSynchronizationSchedule(in *stable.SynchronizationSchedule) []map[st
 	}}
 }
 
+func flattenSynchronizationStatus(in *stable.SynchronizationStatus) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	quarantineReason := ""
+	if in.Quarantine != nil {
+		quarantineReason = string(pointer.From(in.Quarantine.Reason))
+	}
+
+	return []map[string]interface{}{{
+		"code":              string(pointer.From(in.Code)),
+		"last_execution":    flattenSynchronizationTaskExecution(in.LastExecution),
+		"quarantine_reason": quarantineReason,
+	}}
+}
+
+func flattenSynchronizationTaskExecution(in *stable.SynchronizationTaskExecution) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	var errorCode, errorMessage string
+	if in.Error != nil {
+		errorCode = in.Error.Code.GetOrZero()
+		errorMessage = in.Error.Message.GetOrZero()
+	}
+
+	return []map[string]interface{}{{
+		"start_time":    pointer.From(in.TimeBegan),
+		"end_time":      pointer.From(in.TimeEnded),
+		"state":         string(pointer.From(in.State)),
+		"error_code":    errorCode,
+		"error_message": errorMessage,
+	}}
+}
+
 func flattenSynchronizationSecretKeyStringValuePair(in *[]stable.SynchronizationSecretKeyStringValuePair, current []interface{}) []interface{} {
 	if in == nil {
 		return []interface{}{}
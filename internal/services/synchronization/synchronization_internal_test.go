@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSynchronizationJobRetryOnNotFound(t *testing.T) {
+	minTimeout := synchronizationJobRetryMinTimeout
+	t.Cleanup(func() {
+		synchronizationJobRetryMinTimeout = minTimeout
+	})
+	synchronizationJobRetryMinTimeout = 10 * time.Millisecond
+
+	notFound := &http.Response{StatusCode: http.StatusNotFound}
+	badRequest := &http.Response{StatusCode: http.StatusBadRequest}
+
+	cases := []struct {
+		TestName       string
+		NotFoundCalls  int
+		Response       *http.Response
+		Timeout        time.Duration
+		ExpectedCalls  int
+		ExpectingError bool
+	}{
+		{
+			TestName:      "AvailableImmediately",
+			NotFoundCalls: 0,
+			Timeout:       5 * time.Second,
+			ExpectedCalls: 1,
+		},
+		{
+			TestName:      "DelayedAvailability",
+			NotFoundCalls: 3,
+			Timeout:       5 * time.Second,
+			ExpectedCalls: 4,
+		},
+		{
+			TestName:       "OtherError",
+			NotFoundCalls:  1,
+			Response:       badRequest,
+			Timeout:        5 * time.Second,
+			ExpectedCalls:  2,
+			ExpectingError: true,
+		},
+		{
+			TestName:       "NeverAvailable",
+			NotFoundCalls:  1000,
+			Timeout:        500 * time.Millisecond,
+			ExpectingError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), tc.Timeout)
+			defer cancel()
+
+			calls := 0
+			err := synchronizationJobRetryOnNotFound(ctx, func(ctx context.Context) (*http.Response, error) {
+				calls++
+				if calls <= tc.NotFoundCalls {
+					return notFound, errors.New("not found")
+				}
+				if tc.Response != nil {
+					return tc.Response, errors.New("unexpected status")
+				}
+				return &http.Response{StatusCode: http.StatusNoContent}, nil
+			})
+
+			if tc.ExpectingError && err == nil {
+				t.Fatalf("expected an error but got none")
+			}
+			if !tc.ExpectingError && err != nil {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.ExpectedCalls > 0 && calls != tc.ExpectedCalls {
+				t.Fatalf("expected %d calls, got %d", tc.ExpectedCalls, calls)
+			}
+		})
+	}
+}
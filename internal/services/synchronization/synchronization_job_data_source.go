@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func synchronizationJobDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: synchronizationJobDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The ID of the service principal for which the synchronization job exists",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"job_id": {
+				Description:  "The identifier of the synchronization job",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"template_id": {
+				Description: "Identifier of the synchronization template this job is based on",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"status": {
+				Description: "The status of the synchronization job",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"code": {
+							Description: "High-level status code of the synchronization job",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"last_execution": {
+							Description: "Details of the last execution of the synchronization job",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"start_time": {
+										Description: "Time when the execution started",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"end_time": {
+										Description: "Time when the execution ended",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"state": {
+										Description: "The result of the execution",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"error_code": {
+										Description: "The error code of the error encountered during the execution, if any",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"error_message": {
+										Description: "The error message of the error encountered during the execution, if any",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+
+						"quarantine_reason": {
+							Description: "The reason the synchronization job is in quarantine, if any",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func synchronizationJobDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationJobClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	id := stable.NewServicePrincipalIdSynchronizationJobID(servicePrincipalId.ServicePrincipalId, d.Get("job_id").(string))
+
+	resp, err := client.GetSynchronizationJob(ctx, id, synchronizationjob.GetSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "job_id", "%s was not found", id)
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	synchronizationJob := resp.Model
+	if synchronizationJob == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	d.SetId(id.ID())
+
+	tf.Set(d, "status", flattenSynchronizationStatus(synchronizationJob.Status))
+	tf.Set(d, "template_id", synchronizationJob.TemplateId.GetOrZero())
+
+	return nil
+}
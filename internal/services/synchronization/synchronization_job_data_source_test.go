@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SynchronizationJobDataSource struct{}
+
+func TestAccSynchronizationJobDataSource(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"synchronizationJobDataSource": {
+			"basic": testAccSynchronizationJobDataSource_basic,
+		},
+	})
+}
+
+func testAccSynchronizationJobDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_synchronization_job", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: SynchronizationJobDataSource{}.basic(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("template_id").HasValue("dataBricks"),
+			check.That(data.ResourceName).Key("status.#").HasValue("1"),
+			check.That(data.ResourceName).Key("status.0.code").Exists(),
+		),
+	}})
+}
+
+func (SynchronizationJobDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_synchronization_job" "test" {
+  service_principal_id = azuread_synchronization_job.test.service_principal_id
+  job_id               = element(split("/", azuread_synchronization_job.test.id), 5)
+}
+`, SynchronizationJobResource{}.basic(data))
+}
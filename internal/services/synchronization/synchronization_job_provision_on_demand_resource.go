@@ -43,7 +43,7 @@ func synchronizationJobProvisionOnDemandResource() *schema.Resource {
 			},
 
 			"synchronization_job_id": {
-				Description:  "The identifier for the synchronization jop.",
+				Description:  "The identifier for the synchronization job.",
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
@@ -140,7 +140,7 @@ func synchronizationProvisionOnDemandResourceCreate(ctx context.Context, d *sche
 		if response.WasNotFound(jobResp.HttpResponse) {
 			return tf.ErrorDiagPathF(nil, "synchronization_job_id", "%s was not found", jobId)
 		}
-		return tf.ErrorDiagPathF(err, "job_id", "Retrieving %s", jobId)
+		return tf.ErrorDiagPathF(err, "synchronization_job_id", "Retrieving %s", jobId)
 	}
 
 	job := jobResp.Model
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -15,11 +16,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/serviceprincipal"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationtemplate"
 	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
 	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
 	"github.com/hashicorp/terraform-provider-azuread/internal/services/synchronization/migrations"
 )
 
@@ -67,10 +70,21 @@ func synchronizationJobResource() *pluginsdk.Resource {
 			},
 
 			"template_id": {
-				Description: "Identifier of the synchronization template this job is based on.",
-				Type:        pluginsdk.TypeString,
-				Required:    true,
-				ForceNew:    true,
+				Description:  "Identifier of the synchronization template this job is based on.",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"template_id", "template_factory_tag"},
+			},
+
+			"template_factory_tag": {
+				Description:  "The factory tag of the synchronization template this job should be based on. The template ID is resolved from the synchronization templates available to the service principal.",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"template_id", "template_factory_tag"},
+				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
 			"enabled": {
@@ -81,8 +95,11 @@ func synchronizationJobResource() *pluginsdk.Resource {
 			},
 
 			"schedule": {
-				Type:     pluginsdk.TypeList,
-				Computed: true,
+				Description: "The schedule used to run the synchronization job",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"expiration": {
@@ -92,13 +109,15 @@ func synchronizationJobResource() *pluginsdk.Resource {
 						},
 
 						"interval": {
-							Description: "The interval between synchronization iterations ISO8601. E.g. PT40M run every 40 minutes.",
-							Type:        pluginsdk.TypeString,
-							Computed:    true,
+							Description:  "The interval between synchronization iterations ISO8601. E.g. PT40M run every 40 minutes.",
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.ISO8601Duration,
 						},
 
 						"state": {
-							Description: "State.",
+							Description: "State of the job. This is controlled by the `enabled` property.",
 							Type:        pluginsdk.TypeString,
 							Computed:    true,
 						},
@@ -112,6 +131,7 @@ func synchronizationJobResource() *pluginsdk.Resource {
 func synchronizationJobResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Synchronization.SynchronizationJobClient
 	servicePrincipalClient := meta.(*clients.Client).Synchronization.ServicePrincipalClient
+	templateClient := meta.(*clients.Client).Synchronization.SynchronizationTemplateClient
 
 	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
 	if err != nil {
@@ -134,8 +154,21 @@ func synchronizationJobResourceCreate(ctx context.Context, d *pluginsdk.Resource
 		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", servicePrincipalId)
 	}
 
+	templateId := d.Get("template_id").(string)
+	if factoryTag := d.Get("template_factory_tag").(string); factoryTag != "" {
+		templatesResp, err := templateClient.ListSynchronizationTemplates(ctx, *servicePrincipalId, synchronizationtemplate.ListSynchronizationTemplatesOperationOptions{RetryFunc: synchronizationRetryFunc()})
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "template_factory_tag", "Listing synchronization templates for %s", servicePrincipalId)
+		}
+
+		templateId, err = synchronizationTemplateIdForFactoryTag(templatesResp.Model, factoryTag)
+		if err != nil {
+			return tf.ErrorDiagPathF(err, "template_factory_tag", "Resolving synchronization template for %s", servicePrincipalId)
+		}
+	}
+
 	synchronizationJob := stable.SynchronizationJob{
-		TemplateId: nullable.Value(d.Get("template_id").(string)),
+		TemplateId: nullable.Value(templateId),
 	}
 
 	resp, err := client.CreateSynchronizationJob(ctx, *servicePrincipalId, synchronizationJob, synchronizationjob.CreateSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
@@ -167,9 +200,26 @@ func synchronizationJobResourceCreate(ctx context.Context, d *pluginsdk.Resource
 
 	d.SetId(id.ID())
 
-	// Start job if desired
+	// Set the schedule interval, if specified
+	if schedule := expandSynchronizationSchedule(d.Get("schedule").([]interface{})); schedule != nil {
+		properties := stable.SynchronizationJob{
+			Schedule: schedule,
+		}
+		if err = synchronizationJobRetryOnNotFound(ctx, func(ctx context.Context) (*http.Response, error) {
+			resp, err := client.UpdateSynchronizationJob(ctx, id, properties, synchronizationjob.UpdateSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
+			return resp.HttpResponse, err
+		}); err != nil {
+			return tf.ErrorDiagF(err, "Setting schedule for %s", id)
+		}
+	}
+
+	// Start job if desired. The job may not yet be fully available despite being returned above, so we tolerate
+	// transient NotFound responses here.
 	if d.Get("enabled").(bool) {
-		if _, err = client.StartSynchronizationJob(ctx, id, synchronizationjob.StartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+		if err = synchronizationJobRetryOnNotFound(ctx, func(ctx context.Context) (*http.Response, error) {
+			resp, err := client.StartSynchronizationJob(ctx, id, synchronizationjob.StartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()})
+			return resp.HttpResponse, err
+		}); err != nil {
 			return tf.ErrorDiagF(err, "Starting %s", id)
 		}
 	}
@@ -217,6 +267,17 @@ func synchronizationJobResourceUpdate(ctx context.Context, d *pluginsdk.Resource
 		return tf.ErrorDiagPathF(err, "id", "Parsing synchronization job ID %q", d.Id())
 	}
 
+	if d.HasChange("schedule") {
+		if schedule := expandSynchronizationSchedule(d.Get("schedule").([]interface{})); schedule != nil {
+			properties := stable.SynchronizationJob{
+				Schedule: schedule,
+			}
+			if _, err = client.UpdateSynchronizationJob(ctx, *id, properties, synchronizationjob.UpdateSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
+				return tf.ErrorDiagF(err, "Updating schedule for %s", id)
+			}
+		}
+	}
+
 	if d.HasChange("enabled") {
 		if d.Get("enabled").(bool) {
 			if _, err = client.StartSynchronizationJob(ctx, *id, synchronizationjob.StartSynchronizationJobOperationOptions{RetryFunc: synchronizationRetryFunc()}); err != nil {
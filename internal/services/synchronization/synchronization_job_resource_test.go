@@ -23,8 +23,10 @@ type SynchronizationJobResource struct{}
 func TestAccSynchronizationJob(t *testing.T) {
 	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
 		"synchronizationJob": {
-			"basic":    testAccSynchronizationJob_basic,
-			"disabled": testAccSynchronizationJob_disabled,
+			"basic":      testAccSynchronizationJob_basic,
+			"disabled":   testAccSynchronizationJob_disabled,
+			"schedule":   testAccSynchronizationJob_schedule,
+			"factoryTag": testAccSynchronizationJob_factoryTag,
 		},
 	})
 }
@@ -63,6 +65,46 @@ func testAccSynchronizationJob_disabled(t *testing.T) {
 	})
 }
 
+func testAccSynchronizationJob_schedule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job", "test")
+	r := SynchronizationJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.schedule(data, "PT1H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.interval").HasValue("PT1H"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.schedule(data, "PT2H"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.interval").HasValue("PT2H"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccSynchronizationJob_factoryTag(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_synchronization_job", "test")
+	r := SynchronizationJobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.factoryTag(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("template_id").HasValue("dataBricks"),
+			),
+		},
+		data.ImportStep("template_factory_tag"),
+	})
+}
+
 func (r SynchronizationJobResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.ServicePrincipals.SynchronizationJobClient
 
@@ -125,3 +167,29 @@ resource "azuread_synchronization_job" "test" {
 }
 `, r.template(data))
 }
+
+func (r SynchronizationJobResource) schedule(data acceptance.TestData, interval string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_job" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+  template_id          = "dataBricks"
+
+  schedule {
+    interval = "%[2]s"
+  }
+}
+`, r.template(data), interval)
+}
+
+func (r SynchronizationJobResource) factoryTag(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_synchronization_job" "test" {
+  service_principal_id = data.azuread_service_principal.test.id
+  template_factory_tag = "dataBricks"
+}
+`, r.template(data))
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/serviceprincipals/stable/synchronizationjob"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+func synchronizationJobsDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: synchronizationJobsDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_principal_id": {
+				Description:  "The ID of the service principal for which to list synchronization jobs",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: stable.ValidateServicePrincipalID,
+			},
+
+			"jobs": {
+				Description: "A list of synchronization jobs for the service principal",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Description: "The resource ID of the synchronization job",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"job_id": {
+							Description: "The identifier of the synchronization job",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"template_id": {
+							Description: "Identifier of the synchronization template this job is based on",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"enabled": {
+							Description: "Whether or not the synchronization job is enabled",
+							Type:        pluginsdk.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func synchronizationJobsDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Synchronization.SynchronizationJobClient
+
+	servicePrincipalId, err := stable.ParseServicePrincipalID(d.Get("service_principal_id").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "service_principal_id", "Parsing `service_principal_id`")
+	}
+
+	resp, err := client.ListSynchronizationJobsComplete(ctx, *servicePrincipalId, synchronizationjob.ListSynchronizationJobsOperationOptions{RetryFunc: synchronizationRetryFunc()})
+	if err != nil {
+		if response.WasNotFound(resp.LatestHttpResponse) {
+			return tf.ErrorDiagPathF(nil, "service_principal_id", "%s was not found", servicePrincipalId)
+		}
+		return tf.ErrorDiagF(err, "Listing synchronization jobs for %s", servicePrincipalId)
+	}
+
+	ids := make([]string, 0)
+	jobs := make([]map[string]interface{}, 0)
+	for _, job := range resp.Items {
+		if job.Id == nil {
+			return tf.ErrorDiagF(errors.New("API returned synchronization job with nil ID"), "Bad API Response")
+		}
+
+		id := stable.NewServicePrincipalIdSynchronizationJobID(servicePrincipalId.ServicePrincipalId, *job.Id)
+		ids = append(ids, id.ID())
+
+		enabled := false
+		if job.Schedule != nil {
+			enabled = pointer.From(job.Schedule.State) == stable.SynchronizationScheduleState_Active
+		}
+
+		jobs = append(jobs, map[string]interface{}{
+			"id":          id.ID(),
+			"job_id":      *job.Id,
+			"template_id": job.TemplateId.GetOrZero(),
+			"enabled":     enabled,
+		})
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(servicePrincipalId.ID() + "/" + strings.Join(ids, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for synchronization job IDs")
+	}
+
+	d.SetId("synchronizationjobs#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	tf.Set(d, "jobs", jobs)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package synchronization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SynchronizationJobsDataSource struct{}
+
+func TestAccSynchronizationJobsDataSource(t *testing.T) {
+	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
+		"synchronizationJobsDataSource": {
+			"basic": testAccSynchronizationJobsDataSource_basic,
+		},
+	})
+}
+
+func testAccSynchronizationJobsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_synchronization_jobs", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: SynchronizationJobsDataSource{}.basic(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("jobs.#").HasValue("1"),
+			check.That(data.ResourceName).Key("jobs.0.id").Exists(),
+			check.That(data.ResourceName).Key("jobs.0.job_id").Exists(),
+			check.That(data.ResourceName).Key("jobs.0.template_id").HasValue("dataBricks"),
+			check.That(data.ResourceName).Key("jobs.0.enabled").HasValue("true"),
+		),
+	}})
+}
+
+func (SynchronizationJobsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_synchronization_jobs" "test" {
+  service_principal_id = azuread_synchronization_job.test.service_principal_id
+}
+`, SynchronizationJobResource{}.basic(data))
+}
@@ -64,8 +64,9 @@ func synchronizationSecretResource() *pluginsdk.Resource {
 			},
 
 			"credential": {
-				Type:     pluginsdk.TypeList,
-				Optional: true,
+				Description: "One or more key-value pairs to set as synchronization secrets for the service principal",
+				Type:        pluginsdk.TypeList,
+				Optional:    true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"key": {
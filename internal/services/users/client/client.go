@@ -6,19 +6,47 @@ package client
 import (
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/me/stable/me"
 	userBeta "github.com/hashicorp/go-azure-sdk/microsoft-graph/users/beta/user"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationemailmethod"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationphonemethod"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/directreport"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/manager"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
 	"github.com/hashicorp/terraform-provider-azuread/internal/common"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/subscribedsku"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/userphoto"
 )
 
 type Client struct {
-	ManagerClient  *manager.ManagerClient
-	MeClient       *me.MeClient
-	UserClient     *user.UserClient
-	UserClientBeta *userBeta.UserClient
+	AuthenticationEmailMethodClient *authenticationemailmethod.AuthenticationEmailMethodClient
+	AuthenticationPhoneMethodClient *authenticationphonemethod.AuthenticationPhoneMethodClient
+	DirectReportClient              *directreport.DirectReportClient
+	ManagerClient                   *manager.ManagerClient
+	MeClient                        *me.MeClient
+	SubscribedSkuClient             *subscribedsku.SubscribedSkuClient
+	UserClient                      *user.UserClient
+	UserClientBeta                  *userBeta.UserClient
+	UserPhotoClient                 *userphoto.UserPhotoClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
+	authenticationEmailMethodClient, err := authenticationemailmethod.NewAuthenticationEmailMethodClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationEmailMethodClient.Client)
+
+	authenticationPhoneMethodClient, err := authenticationphonemethod.NewAuthenticationPhoneMethodClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(authenticationPhoneMethodClient.Client)
+
+	directReportClient, err := directreport.NewDirectReportClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(directReportClient.Client)
+
 	managerClient, err := manager.NewManagerClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -31,6 +59,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(meClient.Client)
 
+	subscribedSkuClient, err := subscribedsku.NewSubscribedSkuClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(subscribedSkuClient.Client)
+
 	userClient, err := user.NewUserClientWithBaseURI(o.Environment.MicrosoftGraph)
 	if err != nil {
 		return nil, err
@@ -44,10 +78,21 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(userClientBeta.Client)
 
+	userPhotoClient, err := userphoto.NewUserPhotoClientWithBaseURI(o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(userPhotoClient.Client)
+
 	return &Client{
-		ManagerClient:  managerClient,
-		MeClient:       meClient,
-		UserClient:     userClient,
-		UserClientBeta: userClientBeta,
+		AuthenticationEmailMethodClient: authenticationEmailMethodClient,
+		AuthenticationPhoneMethodClient: authenticationPhoneMethodClient,
+		DirectReportClient:              directReportClient,
+		ManagerClient:                   managerClient,
+		MeClient:                        meClient,
+		SubscribedSkuClient:             subscribedSkuClient,
+		UserClient:                      userClient,
+		UserClientBeta:                  userClientBeta,
+		UserPhotoClient:                 userPhotoClient,
 	}, nil
 }
@@ -18,3 +18,15 @@ const (
 )
 
 var possibleValuesForConsentProvidedForMinor = []string{ConsentProvidedForMinorDenied, ConsentProvidedForMinorGranted, ConsentProvidedForMinorNotRequired}
+
+const userResourceName = "azuread_user"
+
+// Authentication phone methods have well-known IDs, since only one phone method of each type can be registered
+var userAuthenticationPhoneMethodIds = map[string]string{
+	"alternateMobile": "b6332ec1-7057-4abe-9331-3d72feddfe41",
+	"mobile":          "3179e48a-750b-4051-897c-87b9720928f7",
+	"office":          "e37fc753-ff3b-4958-9484-eaa9425c82bc",
+}
+
+// The email authentication method has a well-known ID, since only one email method can be registered
+const userAuthenticationEmailMethodId = "3ddfcfc8-9383-446f-83cc-3ab9be4be18f"
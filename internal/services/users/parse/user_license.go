@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type UserLicenseId struct {
+	UserId string
+	SkuId  string
+}
+
+func NewUserLicenseID(userId, skuId string) *UserLicenseId {
+	return &UserLicenseId{
+		UserId: userId,
+		SkuId:  skuId,
+	}
+}
+
+// ParseUserLicenseID parses 'input' into a UserLicenseId
+func ParseUserLicenseID(input string) (*UserLicenseId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&UserLicenseId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := &UserLicenseId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// ValidateUserLicenseID checks that 'input' can be parsed as a User License ID
+func ValidateUserLicenseID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseUserLicenseID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if warnings, errors = validation.IsUUID(id.UserId, "ID"); len(errors) > 0 {
+		return
+	}
+
+	return validation.IsUUID(id.SkuId, "ID")
+}
+
+func (id *UserLicenseId) ID() string {
+	fmtString := "/users/%s/licenses/%s"
+	return fmt.Sprintf(fmtString, id.UserId, id.SkuId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *UserLicenseId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("users", "users", "users"),
+		resourceids.UserSpecifiedSegment("userId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("licenses", "licenses", "licenses"),
+		resourceids.UserSpecifiedSegment("skuId", "11111111-1111-1111-1111-111111111111"),
+	}
+}
+
+func (id *UserLicenseId) String() string {
+	return fmt.Sprintf("User License (User ID: %q, SKU ID: %q)", id.UserId, id.SkuId)
+}
+
+func (id *UserLicenseId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.UserId, ok = input.Parsed["userId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "userId", input)
+	}
+
+	if id.SkuId, ok = input.Parsed["skuId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "skuId", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type UserPasswordId struct {
+	UserId string
+}
+
+func NewUserPasswordID(userId string) *UserPasswordId {
+	return &UserPasswordId{
+		UserId: userId,
+	}
+}
+
+// ParseUserPasswordID parses 'input' into a UserPasswordId
+func ParseUserPasswordID(input string) (*UserPasswordId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&UserPasswordId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := &UserPasswordId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// ValidateUserPasswordID checks that 'input' can be parsed as a User Password ID
+func ValidateUserPasswordID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseUserPasswordID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return validation.IsUUID(id.UserId, "ID")
+}
+
+func (id *UserPasswordId) ID() string {
+	fmtString := "/users/%s/password"
+	return fmt.Sprintf(fmtString, id.UserId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *UserPasswordId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("users", "users", "users"),
+		resourceids.UserSpecifiedSegment("userId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("password", "password", "password"),
+	}
+}
+
+func (id *UserPasswordId) String() string {
+	return fmt.Sprintf("User Password (User ID: %q)", id.UserId)
+}
+
+func (id *UserPasswordId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.UserId, ok = input.Parsed["userId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "userId", input)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+type UserPhotoId struct {
+	UserId string
+}
+
+func NewUserPhotoID(userId string) *UserPhotoId {
+	return &UserPhotoId{
+		UserId: userId,
+	}
+}
+
+// ParseUserPhotoID parses 'input' into a UserPhotoId
+func ParseUserPhotoID(input string) (*UserPhotoId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&UserPhotoId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := &UserPhotoId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// ValidateUserPhotoID checks that 'input' can be parsed as a User Photo ID
+func ValidateUserPhotoID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	id, err := ParseUserPhotoID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	return validation.IsUUID(id.UserId, "ID")
+}
+
+func (id *UserPhotoId) ID() string {
+	fmtString := "/users/%s/photo"
+	return fmt.Sprintf(fmtString, id.UserId)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this ID
+func (id *UserPhotoId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("users", "users", "users"),
+		resourceids.UserSpecifiedSegment("userId", "00000000-0000-0000-0000-000000000000"),
+		resourceids.StaticSegment("photo", "photo", "photo"),
+	}
+}
+
+func (id *UserPhotoId) String() string {
+	return fmt.Sprintf("User Photo (User ID: %q)", id.UserId)
+}
+
+func (id *UserPhotoId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.UserId, ok = input.Parsed["userId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "userId", input)
+	}
+
+	return nil
+}
@@ -27,8 +27,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azuread_user":  userDataSource(),
-		"azuread_users": usersData(),
+		"azuread_subscribed_skus": subscribedSkusDataSource(),
+		"azuread_user":            userDataSource(),
+		"azuread_users":           usersData(),
 	}
 }
 
@@ -36,5 +37,10 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azuread_user": userResource(),
+		"azuread_user_authentication_email_method": userAuthenticationEmailMethodResource(),
+		"azuread_user_authentication_phone_method": userAuthenticationPhoneMethodResource(),
+		"azuread_user_license_assignment":          userLicenseAssignmentResource(),
+		"azuread_user_password":                    userPasswordResource(),
+		"azuread_user_photo":                       userPhotoResource(),
 	}
 }
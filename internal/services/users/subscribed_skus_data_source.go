@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/subscribedsku"
+)
+
+func subscribedSkusDataSource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		ReadContext: subscribedSkusDataSourceRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"skus": {
+				Description: "A list of commercial subscriptions that the organization has acquired",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"sku_id": {
+							Description: "The unique identifier of the SKU",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"sku_part_number": {
+							Description: "The SKU part number, for example `ENTERPRISEPACK`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"applies_to": {
+							Description: "The target class for this SKU, either `User` or `Company`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"capability_status": {
+							Description: "The status of the SKU, for example `Enabled` or `Suspended`",
+							Type:        pluginsdk.TypeString,
+							Computed:    true,
+						},
+
+						"consumed_units": {
+							Description: "The number of licenses that have been assigned",
+							Type:        pluginsdk.TypeInt,
+							Computed:    true,
+						},
+
+						"prepaid_units": {
+							Description: "Information about the number and status of prepaid licenses",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"enabled": {
+										Description: "The number of units that are enabled for the active subscription",
+										Type:        pluginsdk.TypeInt,
+										Computed:    true,
+									},
+
+									"locked_out": {
+										Description: "The number of units that are locked out because the customer cancelled their subscription",
+										Type:        pluginsdk.TypeInt,
+										Computed:    true,
+									},
+
+									"suspended": {
+										Description: "The number of units that are suspended because the subscription has been cancelled",
+										Type:        pluginsdk.TypeInt,
+										Computed:    true,
+									},
+
+									"warning": {
+										Description: "The number of units that are in warning status",
+										Type:        pluginsdk.TypeInt,
+										Computed:    true,
+									},
+								},
+							},
+						},
+
+						"service_plans": {
+							Description: "A list of service plans which are available with the SKU",
+							Type:        pluginsdk.TypeList,
+							Computed:    true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"service_plan_id": {
+										Description: "The unique identifier of the service plan",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"service_plan_name": {
+										Description: "The name of the service plan",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"applies_to": {
+										Description: "The object the service plan can be assigned to, either `User` or `Company`",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+
+									"provisioning_status": {
+										Description: "The provisioning status of the service plan",
+										Type:        pluginsdk.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func subscribedSkusDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.SubscribedSkuClient
+
+	resp, err := client.ListSubscribedSkus(ctx, subscribedsku.DefaultListSubscribedSkusOperationOptions())
+	if err != nil {
+		return tf.ErrorDiagF(err, "Listing subscribed SKUs")
+	}
+
+	skuIds := make([]string, 0)
+	skus := make([]map[string]interface{}, 0)
+	if resp.Model != nil {
+		for _, sku := range *resp.Model {
+			skuIds = append(skuIds, sku.SkuId.GetOrZero())
+			skus = append(skus, map[string]interface{}{
+				"sku_id":            sku.SkuId.GetOrZero(),
+				"sku_part_number":   sku.SkuPartNumber.GetOrZero(),
+				"applies_to":        sku.AppliesTo.GetOrZero(),
+				"capability_status": sku.CapabilityStatus.GetOrZero(),
+				"consumed_units":    int(sku.ConsumedUnits.GetOrZero()),
+				"prepaid_units":     flattenLicenseUnitsDetail(sku.PrepaidUnits),
+				"service_plans":     flattenServicePlanInfo(sku.ServicePlans),
+			})
+		}
+	}
+
+	// Generate a unique ID based on result
+	h := sha1.New()
+	if _, err := h.Write([]byte(strings.Join(skuIds, "/"))); err != nil {
+		return tf.ErrorDiagF(err, "Unable to compute hash for SKU IDs")
+	}
+
+	d.SetId("subscribedSkus#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+
+	tf.Set(d, "skus", skus)
+
+	return nil
+}
+
+func flattenLicenseUnitsDetail(in *stable.LicenseUnitsDetail) []map[string]interface{} {
+	if in == nil {
+		return []map[string]interface{}{}
+	}
+
+	return []map[string]interface{}{{
+		"enabled":    int(in.Enabled.GetOrZero()),
+		"locked_out": int(in.LockedOut.GetOrZero()),
+		"suspended":  int(in.Suspended.GetOrZero()),
+		"warning":    int(in.Warning.GetOrZero()),
+	}}
+}
+
+func flattenServicePlanInfo(in *[]stable.ServicePlanInfo) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if in == nil {
+		return result
+	}
+
+	for _, plan := range *in {
+		result = append(result, map[string]interface{}{
+			"service_plan_id":     plan.ServicePlanId.GetOrZero(),
+			"service_plan_name":   plan.ServicePlanName.GetOrZero(),
+			"applies_to":          plan.AppliesTo.GetOrZero(),
+			"provisioning_status": plan.ProvisioningStatus.GetOrZero(),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+)
+
+type SubscribedSkusDataSource struct{}
+
+func TestAccSubscribedSkusDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_subscribed_skus", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: SubscribedSkusDataSource{}.basic(),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("skus.#").Exists(),
+		),
+	}})
+}
+
+func (SubscribedSkusDataSource) basic() string {
+	return `
+provider "azuread" {}
+
+data "azuread_subscribed_skus" "test" {}
+`
+}
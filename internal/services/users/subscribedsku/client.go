@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package subscribedsku provides a client for the subscribedSkus API, which is not yet available in the
+// microsoft-graph SDK.
+package subscribedsku
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type SubscribedSkuClient struct {
+	Client *msgraph.Client
+}
+
+func NewSubscribedSkuClientWithBaseURI(sdkApi sdkEnv.Api) (*SubscribedSkuClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "subscribedsku", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating SubscribedSkuClient: %+v", err)
+	}
+
+	return &SubscribedSkuClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package subscribedsku
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type ListSubscribedSkusOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *[]stable.SubscribedSku
+}
+
+type ListSubscribedSkusOperationOptions struct {
+	Metadata  *odata.Metadata
+	RetryFunc client.RequestRetryFunc
+}
+
+func DefaultListSubscribedSkusOperationOptions() ListSubscribedSkusOperationOptions {
+	return ListSubscribedSkusOperationOptions{}
+}
+
+func (o ListSubscribedSkusOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+
+	return &out
+}
+
+func (o ListSubscribedSkusOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	if o.Metadata != nil {
+		out.Metadata = *o.Metadata
+	}
+	return &out
+}
+
+func (o ListSubscribedSkusOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+
+	return &out
+}
+
+// ListSubscribedSkus - List subscribedSkus. Get the list of commercial subscriptions that an organization has acquired.
+func (c SubscribedSkuClient) ListSubscribedSkus(ctx context.Context, options ListSubscribedSkusOperationOptions) (result ListSubscribedSkusOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodGet,
+		OptionsObject: options,
+		Path:          "/subscribedSkus",
+		RetryFunc:     options.RetryFunc,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var values struct {
+		Values *[]stable.SubscribedSku `json:"value"`
+	}
+	if err = resp.Unmarshal(&values); err != nil {
+		return
+	}
+
+	result.Model = values.Values
+
+	return
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationemailmethod"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func userAuthenticationEmailMethodResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userAuthenticationEmailMethodResourceCreate,
+		ReadContext:   userAuthenticationEmailMethodResourceRead,
+		UpdateContext: userAuthenticationEmailMethodResourceUpdate,
+		DeleteContext: userAuthenticationEmailMethodResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := stable.ParseUserIdAuthenticationEmailMethodID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user for which to register the email authentication method",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"email_address": {
+				Description:  "The email address to register for self-service password reset",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsEmailAddress,
+			},
+		},
+	}
+}
+
+func userAuthenticationEmailMethodResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationEmailMethodClient
+
+	userId := stable.NewUserID(d.Get("user_object_id").(string))
+
+	tf.LockByName(userResourceName, userId.UserId)
+	defer tf.UnlockByName(userResourceName, userId.UserId)
+
+	// Only one email method can be registered, so check for an existing one before attempting creation
+	id := stable.NewUserIdAuthenticationEmailMethodID(userId.UserId, userAuthenticationEmailMethodId)
+	if resp, err := client.GetAuthenticationEmailMethod(ctx, id, authenticationemailmethod.DefaultGetAuthenticationEmailMethodOperationOptions()); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing %s", id)
+		}
+	} else {
+		return tf.ImportAsExistsDiag("azuread_user_authentication_email_method", id.ID())
+	}
+
+	properties := stable.EmailAuthenticationMethod{
+		EmailAddress: nullable.Value(d.Get("email_address").(string)),
+	}
+
+	resp, err := client.CreateAuthenticationEmailMethod(ctx, userId, properties, authenticationemailmethod.DefaultCreateAuthenticationEmailMethodOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagF(err, "Registering email authentication method for %s", userId)
+	}
+
+	if resp.Model != nil && resp.Model.Id != nil && *resp.Model.Id != "" {
+		id = stable.NewUserIdAuthenticationEmailMethodID(userId.UserId, *resp.Model.Id)
+	}
+
+	d.SetId(id.ID())
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetAuthenticationEmailMethod(ctx, id, authenticationemailmethod.DefaultGetAuthenticationEmailMethodOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return userAuthenticationEmailMethodResourceRead(ctx, d, meta)
+}
+
+func userAuthenticationEmailMethodResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationEmailMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationEmailMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Email Authentication Method ID")
+	}
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	properties := stable.EmailAuthenticationMethod{
+		EmailAddress: nullable.Value(d.Get("email_address").(string)),
+	}
+
+	if _, err = client.UpdateAuthenticationEmailMethod(ctx, *id, properties, authenticationemailmethod.DefaultUpdateAuthenticationEmailMethodOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return userAuthenticationEmailMethodResourceRead(ctx, d, meta)
+}
+
+func userAuthenticationEmailMethodResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationEmailMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationEmailMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Email Authentication Method ID")
+	}
+
+	resp, err := client.GetAuthenticationEmailMethod(ctx, *id, authenticationemailmethod.DefaultGetAuthenticationEmailMethodOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	method := resp.Model
+	if method == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "user_object_id", id.UserId)
+	tf.Set(d, "email_address", method.EmailAddress.GetOrZero())
+
+	return nil
+}
+
+func userAuthenticationEmailMethodResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationEmailMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationEmailMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Email Authentication Method ID")
+	}
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	if resp, err := client.DeleteAuthenticationEmailMethod(ctx, *id, authenticationemailmethod.DefaultDeleteAuthenticationEmailMethodOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetAuthenticationEmailMethod(ctx, *id, authenticationemailmethod.DefaultGetAuthenticationEmailMethodOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %v", id, err)
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
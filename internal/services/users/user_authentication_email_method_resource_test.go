@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationemailmethod"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type UserAuthenticationEmailMethodResource struct{}
+
+func TestAccUserAuthenticationEmailMethod_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_authentication_email_method", "test")
+	r := UserAuthenticationEmailMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("email_address").HasValue(fmt.Sprintf("acctest-first-%d@example.com", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserAuthenticationEmailMethod_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_authentication_email_method", "test")
+	r := UserAuthenticationEmailMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("email_address").HasValue(fmt.Sprintf("acctest-second-%d@example.com", data.RandomInteger)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserAuthenticationEmailMethod_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_authentication_email_method", "test")
+	r := UserAuthenticationEmailMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r UserAuthenticationEmailMethodResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.AuthenticationEmailMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationEmailMethodID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Email Authentication Method ID: %v", err)
+	}
+
+	resp, err := client.GetAuthenticationEmailMethod(ctx, *id, authenticationemailmethod.DefaultGetAuthenticationEmailMethodOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (UserAuthenticationEmailMethodResource) basic(data acceptance.TestData, prefix string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+
+resource "azuread_user_authentication_email_method" "test" {
+  user_object_id = azuread_user.test.object_id
+  email_address  = "acctest-%[3]s-%[1]d@example.com"
+}
+`, data.RandomInteger, data.RandomPassword, prefix)
+}
+
+func (r UserAuthenticationEmailMethodResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_authentication_email_method" "import" {
+  user_object_id = azuread_user_authentication_email_method.test.user_object_id
+  email_address  = azuread_user_authentication_email_method.test.email_address
+}
+`, r.basic(data, "first"))
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationphonemethod"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+)
+
+func userAuthenticationPhoneMethodResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userAuthenticationPhoneMethodResourceCreate,
+		ReadContext:   userAuthenticationPhoneMethodResourceRead,
+		UpdateContext: userAuthenticationPhoneMethodResourceUpdate,
+		DeleteContext: userAuthenticationPhoneMethodResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := stable.ParseUserIdAuthenticationPhoneMethodID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user for which to register the phone authentication method",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"phone_type": {
+				Description:  "The type of phone. Only one phone method of each type can be registered for a user",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(stable.PossibleValuesForAuthenticationPhoneType(), false),
+			},
+
+			"phone_number": {
+				Description:  "The phone number to text or call for authentication, in the format `+{country code} {number}x{extension}`, with the extension being optional",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\+[0-9]{1,3} [0-9]+(x[0-9]+)?$`), "phone number must be in the format `+{country code} {number}x{extension}`, e.g. `+1 5555551234`"),
+			},
+
+			"sms_sign_in_state": {
+				Description: "Whether the phone is ready to be used for SMS sign-in",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func userAuthenticationPhoneMethodResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationPhoneMethodClient
+
+	userId := stable.NewUserID(d.Get("user_object_id").(string))
+	phoneType := d.Get("phone_type").(string)
+
+	tf.LockByName(userResourceName, userId.UserId)
+	defer tf.UnlockByName(userResourceName, userId.UserId)
+
+	// Only one phone method of each type can be registered, so check for an existing one before attempting creation
+	id := stable.NewUserIdAuthenticationPhoneMethodID(userId.UserId, userAuthenticationPhoneMethodIds[phoneType])
+	if resp, err := client.GetAuthenticationPhoneMethod(ctx, id, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions()); err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing %s", id)
+		}
+	} else {
+		return tf.ImportAsExistsDiag("azuread_user_authentication_phone_method", id.ID())
+	}
+
+	// A user cannot have an alternate mobile phone without first having a primary mobile phone
+	if stable.AuthenticationPhoneType(phoneType) == stable.AuthenticationPhoneType_AlternateMobile {
+		mobileId := stable.NewUserIdAuthenticationPhoneMethodID(userId.UserId, userAuthenticationPhoneMethodIds[string(stable.AuthenticationPhoneType_Mobile)])
+		if resp, err := client.GetAuthenticationPhoneMethod(ctx, mobileId, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return tf.ErrorDiagPathF(nil, "phone_type", "%s must have a `mobile` phone method registered before an `alternateMobile` phone method can be added", userId)
+			}
+			return tf.ErrorDiagF(err, "Checking for existing %s", mobileId)
+		}
+	}
+
+	properties := stable.PhoneAuthenticationMethod{
+		PhoneNumber: nullable.Value(d.Get("phone_number").(string)),
+		PhoneType:   pointer.To(stable.AuthenticationPhoneType(phoneType)),
+	}
+
+	resp, err := client.CreateAuthenticationPhoneMethod(ctx, userId, properties, authenticationphonemethod.DefaultCreateAuthenticationPhoneMethodOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagF(err, "Registering %s phone authentication method for %s", phoneType, userId)
+	}
+
+	if resp.Model != nil && resp.Model.Id != nil && *resp.Model.Id != "" {
+		id = stable.NewUserIdAuthenticationPhoneMethodID(userId.UserId, *resp.Model.Id)
+	}
+
+	d.SetId(id.ID())
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetAuthenticationPhoneMethod(ctx, id, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions())
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(resp.Model != nil), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for creation of %s", id)
+	}
+
+	return userAuthenticationPhoneMethodResourceRead(ctx, d, meta)
+}
+
+func userAuthenticationPhoneMethodResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationPhoneMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationPhoneMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Phone Authentication Method ID")
+	}
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	properties := stable.PhoneAuthenticationMethod{
+		PhoneNumber: nullable.Value(d.Get("phone_number").(string)),
+		PhoneType:   pointer.To(stable.AuthenticationPhoneType(d.Get("phone_type").(string))),
+	}
+
+	if _, err = client.UpdateAuthenticationPhoneMethod(ctx, *id, properties, authenticationphonemethod.DefaultUpdateAuthenticationPhoneMethodOperationOptions()); err != nil {
+		return tf.ErrorDiagF(err, "Updating %s", id)
+	}
+
+	return userAuthenticationPhoneMethodResourceRead(ctx, d, meta)
+}
+
+func userAuthenticationPhoneMethodResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationPhoneMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationPhoneMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Phone Authentication Method ID")
+	}
+
+	resp, err := client.GetAuthenticationPhoneMethod(ctx, *id, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", id)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", id)
+	}
+
+	method := resp.Model
+	if method == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", id)
+	}
+
+	tf.Set(d, "user_object_id", id.UserId)
+	tf.Set(d, "phone_number", method.PhoneNumber.GetOrZero())
+	tf.Set(d, "phone_type", string(pointer.From(method.PhoneType)))
+	tf.Set(d, "sms_sign_in_state", string(pointer.From(method.SmsSignInState)))
+
+	return nil
+}
+
+func userAuthenticationPhoneMethodResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.AuthenticationPhoneMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationPhoneMethodID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing Phone Authentication Method ID")
+	}
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	// The primary mobile phone cannot be removed whilst an alternate mobile phone is registered
+	if strings.EqualFold(id.PhoneAuthenticationMethodId, userAuthenticationPhoneMethodIds[string(stable.AuthenticationPhoneType_Mobile)]) {
+		alternateId := stable.NewUserIdAuthenticationPhoneMethodID(id.UserId, userAuthenticationPhoneMethodIds[string(stable.AuthenticationPhoneType_AlternateMobile)])
+		if resp, err := client.GetAuthenticationPhoneMethod(ctx, alternateId, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions()); err == nil {
+			return tf.ErrorDiagF(errors.New("an `alternateMobile` phone method is registered"), "Cannot delete %s, the `alternateMobile` phone method must be removed first (when managing both, add a `depends_on` reference to the `mobile` phone method resource so that the `alternateMobile` phone method is destroyed first)", id)
+		} else if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Checking for existing %s", alternateId)
+		}
+	}
+
+	if resp, err := client.DeleteAuthenticationPhoneMethod(ctx, *id, authenticationphonemethod.DefaultDeleteAuthenticationPhoneMethodOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was already deleted", id)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting %s", id)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetAuthenticationPhoneMethod(ctx, *id, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions()); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %v", id, err)
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of %s", id)
+	}
+
+	return nil
+}
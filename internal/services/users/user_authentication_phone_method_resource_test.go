@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/authenticationphonemethod"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+)
+
+type UserAuthenticationPhoneMethodResource struct{}
+
+func TestAccUserAuthenticationPhoneMethod_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_authentication_phone_method", "test")
+	r := UserAuthenticationPhoneMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+44 7700900123"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("phone_type").HasValue("mobile"),
+				check.That(data.ResourceName).Key("phone_number").HasValue("+44 7700900123"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserAuthenticationPhoneMethod_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_authentication_phone_method", "test")
+	r := UserAuthenticationPhoneMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+44 7700900123"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "+44 7700900456"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("phone_number").HasValue("+44 7700900456"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserAuthenticationPhoneMethod_alternateMobile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_authentication_phone_method", "test")
+	r := UserAuthenticationPhoneMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.alternateMobile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azuread_user_authentication_phone_method.alternate").ExistsInAzure(r),
+				check.That("azuread_user_authentication_phone_method.alternate").Key("phone_type").HasValue("alternateMobile"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserAuthenticationPhoneMethod_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_authentication_phone_method", "test")
+	r := UserAuthenticationPhoneMethodResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "+44 7700900123"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport(data)),
+	})
+}
+
+func (r UserAuthenticationPhoneMethodResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.AuthenticationPhoneMethodClient
+
+	id, err := stable.ParseUserIdAuthenticationPhoneMethodID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Phone Authentication Method ID: %v", err)
+	}
+
+	resp, err := client.GetAuthenticationPhoneMethod(ctx, *id, authenticationphonemethod.DefaultGetAuthenticationPhoneMethodOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (UserAuthenticationPhoneMethodResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserAuthenticationPhoneMethodResource) basic(data acceptance.TestData, phoneNumber string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_authentication_phone_method" "test" {
+  user_object_id = azuread_user.test.object_id
+  phone_type     = "mobile"
+  phone_number   = "%[2]s"
+}
+`, r.template(data), phoneNumber)
+}
+
+func (r UserAuthenticationPhoneMethodResource) alternateMobile(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_authentication_phone_method" "alternate" {
+  user_object_id = azuread_user.test.object_id
+  phone_type     = "alternateMobile"
+  phone_number   = "+44 7700900789"
+
+  depends_on = [azuread_user_authentication_phone_method.test]
+}
+`, r.basic(data, "+44 7700900123"))
+}
+
+func (r UserAuthenticationPhoneMethodResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_authentication_phone_method" "import" {
+  user_object_id = azuread_user_authentication_phone_method.test.user_object_id
+  phone_type     = azuread_user_authentication_phone_method.test.phone_type
+  phone_number   = azuread_user_authentication_phone_method.test.phone_number
+}
+`, r.basic(data, "+44 7700900123"))
+}
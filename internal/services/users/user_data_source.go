@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/directreport"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/manager"
 	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
 	"github.com/hashicorp/go-azure-sdk/sdk/odata"
@@ -75,6 +76,13 @@ func userDataSource() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"include_direct_reports": {
+				Description: "Whether to retrieve the object IDs of the user's direct reports",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
 			"account_enabled": {
 				Description: "Whether or not the account is enabled",
 				Type:        pluginsdk.TypeBool,
@@ -138,6 +146,15 @@ func userDataSource() *pluginsdk.Resource {
 				Computed:    true,
 			},
 
+			"direct_reports": {
+				Description: "The object IDs of the users and contacts that report to the user. Only populated when `include_direct_reports` is `true`",
+				Type:        pluginsdk.TypeList,
+				Computed:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
 			"display_name": {
 				Description: "The display name of the user",
 				Type:        pluginsdk.TypeString,
@@ -320,6 +337,7 @@ func userDataSource() *pluginsdk.Resource {
 
 func userDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Users.UserClient
+	directReportClient := meta.(*clients.Client).Users.DirectReportClient
 	managerClient := meta.(*clients.Client).Users.ManagerClient
 
 	var foundObjectId *string
@@ -557,5 +575,22 @@ func userDataSourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta int
 	}
 	tf.Set(d, "manager_id", managerId)
 
+	directReports := make([]string, 0)
+	if d.Get("include_direct_reports").(bool) {
+		options := directreport.ListDirectReportsOperationOptions{
+			Select: &[]string{"id"},
+		}
+		directReportsResp, err := directReportClient.ListDirectReportsComplete(ctx, id, options)
+		if err != nil {
+			return tf.ErrorDiagF(err, "Could not retrieve direct reports for %s", id)
+		}
+		for _, directReport := range directReportsResp.Items {
+			if objectId := pointer.From(directReport.DirectoryObject().Id); objectId != "" {
+				directReports = append(directReports, objectId)
+			}
+		}
+	}
+	tf.Set(d, "direct_reports", directReports)
+
 	return nil
 }
@@ -109,12 +109,25 @@ func TestAccUserDataSource_byEmployeeIdNonexistent(t *testing.T) {
 	}})
 }
 
+func TestAccUserDataSource_directReports(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azuread_user", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{{
+		Config: UserDataSource{}.directReports(data),
+		Check: acceptance.ComposeTestCheckFunc(
+			check.That(data.ResourceName).Key("direct_reports.#").HasValue("1"),
+			check.That(data.ResourceName).Key("direct_reports.0").MatchesOtherKey(check.That("azuread_user.test").Key("object_id")),
+		),
+	}})
+}
+
 func (UserDataSource) testCheckFunc(data acceptance.TestData) acceptance.TestCheckFunc {
 	return acceptance.ComposeTestCheckFunc(
 		check.That(data.ResourceName).Key("account_enabled").Exists(),
 		check.That(data.ResourceName).Key("city").HasValue(fmt.Sprintf("acctestUser-%d-City", data.RandomInteger)),
 		check.That(data.ResourceName).Key("company_name").HasValue(fmt.Sprintf("acctestUser-%d-Company", data.RandomInteger)),
 		check.That(data.ResourceName).Key("country").HasValue(fmt.Sprintf("acctestUser-%d-Country", data.RandomInteger)),
+		check.That(data.ResourceName).Key("direct_reports.#").HasValue("0"),
 		check.That(data.ResourceName).Key("department").HasValue(fmt.Sprintf("acctestUser-%d-Dept", data.RandomInteger)),
 		check.That(data.ResourceName).Key("display_name").HasValue(fmt.Sprintf("acctestUser-%d-DisplayName", data.RandomInteger)),
 		check.That(data.ResourceName).Key("given_name").HasValue(fmt.Sprintf("acctestUser-%d-GivenName", data.RandomInteger)),
@@ -241,3 +254,16 @@ data "azuread_user" "test" {
 }
 `
 }
+
+func (UserDataSource) directReports(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azuread_user" "test" {
+  object_id              = azuread_user.manager.object_id
+  include_direct_reports = true
+
+  depends_on = [azuread_user.test]
+}
+`, UserResource{}.complete(data))
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/licenses"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+)
+
+func userLicenseAssignmentResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userLicenseAssignmentResourceCreate,
+		ReadContext:   userLicenseAssignmentResourceRead,
+		UpdateContext: userLicenseAssignmentResourceUpdate,
+		DeleteContext: userLicenseAssignmentResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			if _, errs := parse.ValidateUserLicenseID(id, "id"); len(errs) > 0 {
+				return errs[0]
+			}
+			return nil
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user to which the license should be assigned",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"sku_id": licenses.SkuIdSchema(),
+
+			"disabled_plans": licenses.DisabledPlansSchema(),
+		},
+	}
+}
+
+func userLicenseAssignmentResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	resourceId := parse.NewUserLicenseID(d.Get("user_object_id").(string), d.Get("sku_id").(string))
+	userId := stable.NewUserID(resourceId.UserId)
+
+	tf.LockByName(userResourceName, resourceId.UserId)
+	defer tf.UnlockByName(userResourceName, resourceId.UserId)
+
+	options := user.GetUserOperationOptions{
+		Select: &[]string{"id", "usageLocation"},
+	}
+	resp, err := client.GetUser(ctx, userId, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagPathF(err, "user_object_id", "Retrieving %s", userId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagPathF(nil, "user_object_id", "Retrieving %s: model was nil", userId)
+	}
+
+	// Licenses cannot be assigned to users without a usage location, and the API error is not particularly helpful
+	if resp.Model.UsageLocation.GetOrZero() == "" {
+		return tf.ErrorDiagPathF(nil, "user_object_id", "%s does not have a usage location. Set the `usage_location` property for the user before assigning licenses", userId)
+	}
+
+	existing, err := userGetAssignedLicense(ctx, client, userId, resourceId.SkuId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Checking for existing license %q for %s", resourceId.SkuId, userId)
+	}
+	if existing != nil {
+		return tf.ImportAsExistsDiag("azuread_user_license_assignment", resourceId.ID())
+	}
+
+	disabledPlans := tf.ExpandStringSlice(d.Get("disabled_plans").(*pluginsdk.Set).List())
+
+	if err = userAssignLicense(ctx, client, userId, resourceId.SkuId, disabledPlans); err != nil {
+		return tf.ErrorDiagF(err, "Assigning license %q to %s", resourceId.SkuId, userId)
+	}
+
+	d.SetId(resourceId.ID())
+
+	return userLicenseAssignmentResourceRead(ctx, d, meta)
+}
+
+func userLicenseAssignmentResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	resourceId, err := parse.ParseUserLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User License ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	tf.LockByName(userResourceName, resourceId.UserId)
+	defer tf.UnlockByName(userResourceName, resourceId.UserId)
+
+	// Assigning a license which is already assigned replaces its disabled plans
+	disabledPlans := tf.ExpandStringSlice(d.Get("disabled_plans").(*pluginsdk.Set).List())
+
+	if err = userAssignLicense(ctx, client, userId, resourceId.SkuId, disabledPlans); err != nil {
+		return tf.ErrorDiagF(err, "Updating license %q for %s", resourceId.SkuId, userId)
+	}
+
+	return userLicenseAssignmentResourceRead(ctx, d, meta)
+}
+
+func userLicenseAssignmentResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	resourceId, err := parse.ParseUserLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User License ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	license, err := userGetAssignedLicense(ctx, client, userId, resourceId.SkuId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving license %q for %s", resourceId.SkuId, userId)
+	}
+	if license == nil {
+		log.Printf("[DEBUG] License %q for %s was not found - removing from state", resourceId.SkuId, userId)
+		d.SetId("")
+		return nil
+	}
+
+	tf.Set(d, "user_object_id", resourceId.UserId)
+	tf.Set(d, "sku_id", resourceId.SkuId)
+	tf.Set(d, "disabled_plans", tf.FlattenStringSlicePtr(license.DisabledPlans))
+
+	return nil
+}
+
+func userLicenseAssignmentResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	resourceId, err := parse.ParseUserLicenseID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User License ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	tf.LockByName(userResourceName, resourceId.UserId)
+	defer tf.UnlockByName(userResourceName, resourceId.UserId)
+
+	request := user.AssignLicenseRequest{
+		AddLicenses:    &[]stable.AssignedLicense{},
+		RemoveLicenses: &[]string{resourceId.SkuId},
+	}
+
+	if resp, err := client.AssignLicense(ctx, userId, request, user.DefaultAssignLicenseOperationOptions()); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found", userId)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Removing license %q from %s", resourceId.SkuId, userId)
+	}
+
+	if err := licenses.WaitForRemoval(ctx, userAssignedLicenseFunc(client, userId, resourceId.SkuId)); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for removal of license %q from %s", resourceId.SkuId, userId)
+	}
+
+	return nil
+}
+
+// userGetAssignedLicense returns the license with the specified SKU ID which is assigned to the user, or nil if the
+// user does not exist or the license is not assigned.
+func userGetAssignedLicense(ctx context.Context, client *user.UserClient, id stable.UserId, skuId string) (*stable.AssignedLicense, error) {
+	options := user.GetUserOperationOptions{
+		Select: &[]string{"assignedLicenses"},
+	}
+
+	resp, err := client.GetUser(ctx, id, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if resp.Model != nil && resp.Model.AssignedLicenses != nil {
+		for _, license := range *resp.Model.AssignedLicenses {
+			if strings.EqualFold(license.SkuId.GetOrZero(), skuId) {
+				return &license, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// userAssignedLicenseFunc returns a function which retrieves the license with the specified SKU ID assigned to the user
+func userAssignedLicenseFunc(client *user.UserClient, id stable.UserId, skuId string) licenses.AssignedLicenseFunc {
+	return func(ctx context.Context) (bool, *[]string, error) {
+		license, err := userGetAssignedLicense(ctx, client, id, skuId)
+		if err != nil || license == nil {
+			return false, nil, err
+		}
+		return true, license.DisabledPlans, nil
+	}
+}
+
+// userAssignLicense assigns the license with the specified SKU ID to the user, and waits for the license to be
+// reflected with the expected disabled plans. The caller is expected to hold the lock for the user.
+func userAssignLicense(ctx context.Context, client *user.UserClient, id stable.UserId, skuId string, disabledPlans []string) error {
+	request := user.AssignLicenseRequest{
+		AddLicenses: &[]stable.AssignedLicense{
+			{
+				SkuId:         nullable.Value(skuId),
+				DisabledPlans: pointer.To(disabledPlans),
+			},
+		},
+		RemoveLicenses: &[]string{},
+	}
+
+	if resp, err := client.AssignLicense(ctx, id, request, user.DefaultAssignLicenseOperationOptions()); err != nil {
+		if resp.HttpResponse != nil && resp.HttpResponse.StatusCode == http.StatusBadRequest && strings.Contains(strings.ToLower(err.Error()), "usage location") {
+			return fmt.Errorf("the user does not have a valid usage location, set the `usage_location` property for the user before assigning licenses: %v", err)
+		}
+		return err
+	}
+
+	return licenses.WaitForAssignment(ctx, disabledPlans, userAssignedLicenseFunc(client, id, skuId))
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserLicenseAssignmentResource struct{}
+
+func TestAccUserLicenseAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_license_assignment", "test")
+	r := UserLicenseAssignmentResource{}
+
+	skuId := os.Getenv("ARM_TEST_LICENSE_SKU_ID")
+	if skuId == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_ID must be set to the ID of a SKU subscribed in the test tenant")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, skuId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("sku_id").HasValue(skuId),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccUserLicenseAssignment_addAndRemove(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_license_assignment", "test")
+	r := UserLicenseAssignmentResource{}
+
+	skuId := os.Getenv("ARM_TEST_LICENSE_SKU_ID")
+	planId := os.Getenv("ARM_TEST_LICENSE_SERVICE_PLAN_ID")
+	if skuId == "" || planId == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_ID and ARM_TEST_LICENSE_SERVICE_PLAN_ID must be set to the IDs of a SKU subscribed in the test tenant and one of its service plans")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.userOnly(data, `"GB"`),
+		},
+		{
+			Config: r.basic(data, skuId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.disabledPlans(data, skuId, planId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("disabled_plans.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.userOnly(data, `"GB"`),
+		},
+	})
+}
+
+func TestAccUserLicenseAssignment_noUsageLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_license_assignment", "test")
+	r := UserLicenseAssignmentResource{}
+
+	skuId := os.Getenv("ARM_TEST_LICENSE_SKU_ID")
+	if skuId == "" {
+		t.Skip("ARM_TEST_LICENSE_SKU_ID must be set to the ID of a SKU subscribed in the test tenant")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.noUsageLocation(data, skuId),
+			ExpectError: regexp.MustCompile("does not have a usage location"),
+		},
+	})
+}
+
+func (r UserLicenseAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.UserClient
+
+	id, err := parse.ParseUserLicenseID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing User License ID: %v", err)
+	}
+
+	options := user.GetUserOperationOptions{
+		Select: &[]string{"assignedLicenses"},
+	}
+
+	resp, err := client.GetUser(ctx, stable.NewUserID(id.UserId), options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving user with object ID %q: %+v", id.UserId, err)
+	}
+
+	if resp.Model != nil && resp.Model.AssignedLicenses != nil {
+		for _, license := range *resp.Model.AssignedLicenses {
+			if strings.EqualFold(license.SkuId.GetOrZero(), id.SkuId) {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (UserLicenseAssignmentResource) userOnly(data acceptance.TestData, usageLocation string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+  usage_location      = %[3]s
+}
+`, data.RandomInteger, data.RandomPassword, usageLocation)
+}
+
+func (r UserLicenseAssignmentResource) basic(data acceptance.TestData, skuId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_license_assignment" "test" {
+  user_object_id = azuread_user.test.object_id
+  sku_id         = "%[2]s"
+}
+`, r.userOnly(data, `"GB"`), skuId)
+}
+
+func (r UserLicenseAssignmentResource) disabledPlans(data acceptance.TestData, skuId, planId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_license_assignment" "test" {
+  user_object_id = azuread_user.test.object_id
+  sku_id         = "%[2]s"
+  disabled_plans = ["%[3]s"]
+}
+`, r.userOnly(data, `"GB"`), skuId, planId)
+}
+
+func (r UserLicenseAssignmentResource) noUsageLocation(data acceptance.TestData, skuId string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_license_assignment" "test" {
+  user_object_id = azuread_user.test.object_id
+  sku_id         = "%[2]s"
+}
+`, r.userOnly(data, "null"), skuId)
+}
@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/go-azure-sdk/sdk/nullable"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+)
+
+func userPasswordResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userPasswordResourceCreate,
+		ReadContext:   userPasswordResourceRead,
+		UpdateContext: userPasswordResourceUpdate,
+		DeleteContext: userPasswordResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user for which to set the password",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"password": {
+				Description:  "The password to set for the user. The password must satisfy minimum requirements as specified by the password policy. The maximum length is 256 characters",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(1, 256), // Currently the max length for AAD passwords is 256
+			},
+
+			"force_change_password_next_sign_in": {
+				Description: "Whether the user is forced to change the password during the next sign-in",
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+
+			"rotate_when_changed": {
+				Description: "Arbitrary map of values that, when changed, will trigger the password to be set again",
+				Type:        pluginsdk.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"last_password_change_date_time": {
+				Description: "The time when the user last changed their password, or when their password was last set",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func userPasswordResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	id := parse.NewUserPasswordID(d.Get("user_object_id").(string))
+
+	if diags := userPasswordResourceSetPassword(ctx, d, meta, *id); diags != nil {
+		return diags
+	}
+
+	d.SetId(id.ID())
+
+	return userPasswordResourceRead(ctx, d, meta)
+}
+
+func userPasswordResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	id, err := parse.ParseUserPasswordID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User Password ID")
+	}
+
+	if diags := userPasswordResourceSetPassword(ctx, d, meta, *id); diags != nil {
+		// Flag the state as 'partial' to avoid setting `password` from the current config. Since the config is the
+		// only source for this property, if the update fails due to a bad password, the current password will be forgotten
+		// and Terraform will not offer a diff in the next plan.
+		d.Partial(true) //lintignore:R007
+
+		return diags
+	}
+
+	return userPasswordResourceRead(ctx, d, meta)
+}
+
+func userPasswordResourceSetPassword(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id parse.UserPasswordId) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+	userId := stable.NewUserID(id.UserId)
+
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
+	properties := stable.User{
+		PasswordProfile: &stable.PasswordProfile{
+			ForceChangePasswordNextSignIn: nullable.Value(d.Get("force_change_password_next_sign_in").(bool)),
+			Password:                      nullable.Value(d.Get("password").(string)),
+		},
+	}
+
+	resp, err := client.UpdateUser(ctx, userId, properties, user.DefaultUpdateUserOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		if message := userPasswordPolicyViolation(resp.OData); message != "" {
+			return tf.ErrorDiagPathF(errors.New(message), "password", "The password for %s does not satisfy the password policy", userId)
+		}
+		return tf.ErrorDiagF(err, "Setting password for %s", userId)
+	}
+
+	return nil
+}
+
+func userPasswordResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserClient
+
+	id, err := parse.ParseUserPasswordID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User Password ID")
+	}
+
+	userId := stable.NewUserID(id.UserId)
+
+	options := user.GetUserOperationOptions{
+		Select: &[]string{"id", "lastPasswordChangeDateTime"},
+	}
+
+	resp, err := client.GetUser(ctx, userId, options)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state", userId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving %s", userId)
+	}
+
+	u := resp.Model
+	if u == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving %s", userId)
+	}
+
+	tf.Set(d, "user_object_id", id.UserId)
+	tf.Set(d, "last_password_change_date_time", u.LastPasswordChangeDateTime.GetOrZero())
+
+	return nil
+}
+
+func userPasswordResourceDelete(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) pluginsdk.Diagnostics {
+	// A user must always have a password, so there is nothing to remove. The current password is left in place.
+	log.Printf("[DEBUG] Removing %s from state, the user's current password will not be changed", d.Id())
+	return nil
+}
+
+// userPasswordPolicyViolation returns the error message from the API when it has rejected a password for failing to
+// satisfy the password policy, or an empty string when the error was caused by something else. The message is passed
+// through as-is, since password policies vary between tenants and the API describes which requirement was not met.
+func userPasswordPolicyViolation(o *odata.OData) string {
+	if o == nil || o.Error == nil {
+		return ""
+	}
+
+	if !o.Error.Match("(?i)password complexity|password does not comply|password policy|PasswordPolicy") {
+		return ""
+	}
+
+	if message := strings.TrimSpace(pointer.From(o.Error.Message)); message != "" {
+		return message
+	}
+
+	return o.Error.String()
+}
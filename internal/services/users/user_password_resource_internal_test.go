@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+func TestUserPasswordPolicyViolation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    *odata.OData
+		expected string
+	}{
+		{
+			name:  "nil response",
+			input: nil,
+		},
+		{
+			name:  "no error",
+			input: &odata.OData{},
+		},
+		{
+			name: "unrelated error",
+			input: &odata.OData{
+				Error: &odata.Error{
+					Code:    pointer.To("Request_BadRequest"),
+					Message: pointer.To("One or more property values specified are invalid."),
+				},
+			},
+		},
+		{
+			name: "complexity requirements",
+			input: &odata.OData{
+				Error: &odata.Error{
+					Code:    pointer.To("Request_BadRequest"),
+					Message: pointer.To("The specified password does not comply with password complexity requirements. Please provide a different password."),
+				},
+			},
+			expected: "The specified password does not comply with password complexity requirements. Please provide a different password.",
+		},
+		{
+			name: "policy error without a message",
+			input: &odata.OData{
+				Error: &odata.Error{
+					Code: pointer.To("PasswordPolicyViolation"),
+				},
+			},
+			expected: "PasswordPolicyViolation",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := userPasswordPolicyViolation(tc.input)
+			if result != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+)
+
+type UserPasswordResource struct{}
+
+func TestAccUserPassword_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_password", "test")
+	r := UserPasswordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("user_object_id").IsUuid(),
+				check.That(data.ResourceName).Key("force_change_password_next_sign_in").HasValue("true"),
+				check.That(data.ResourceName).Key("last_password_change_date_time").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccUserPassword_rotate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_password", "test")
+	r := UserPasswordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.basic(data, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotate_when_changed.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccUserPassword_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_password", "test")
+	r := UserPasswordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.noForceChange(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("force_change_password_next_sign_in").HasValue("false"),
+			),
+		},
+	})
+}
+
+func TestAccUserPassword_policyViolation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_password", "test")
+	r := UserPasswordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.weakPassword(data),
+			ExpectError: regexp.MustCompile("does not satisfy the password policy"),
+		},
+	})
+}
+
+func (r UserPasswordResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.UserClient
+
+	id, err := parse.ParseUserPasswordID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing User Password ID: %v", err)
+	}
+
+	resp, err := client.GetUser(ctx, stable.NewUserID(id.UserId), user.DefaultGetUserOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving user with object ID %q: %+v", id.UserId, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (UserPasswordResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name        = "acctestUser-%[1]d"
+  password            = "%[2]s"
+
+  lifecycle {
+    ignore_changes = [password]
+  }
+}
+`, data.RandomInteger, data.RandomPassword)
+}
+
+func (r UserPasswordResource) basic(data acceptance.TestData, rotation string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_password" "test" {
+  user_object_id                     = azuread_user.test.object_id
+  password                           = "%[2]s-%[3]s"
+  force_change_password_next_sign_in = true
+
+  rotate_when_changed = {
+    rotation = "%[3]s"
+  }
+}
+`, r.template(data), data.RandomPassword, rotation)
+}
+
+func (r UserPasswordResource) noForceChange(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_password" "test" {
+  user_object_id = azuread_user.test.object_id
+  password       = "%[2]s-updated"
+
+  rotate_when_changed = {
+    rotation = "1"
+  }
+}
+`, r.template(data), data.RandomPassword)
+}
+
+func (r UserPasswordResource) weakPassword(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azuread_user_password" "test" {
+  user_object_id = azuread_user.test.object_id
+  password       = "password"
+}
+`, r.template(data))
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/users/stable/user"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/consistency"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/validation"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/userphoto"
+	usersValidate "github.com/hashicorp/terraform-provider-azuread/internal/services/users/validate"
+)
+
+func userPhotoResource() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		CreateContext: userPhotoResourceCreate,
+		ReadContext:   userPhotoResourceRead,
+		UpdateContext: userPhotoResourceUpdate,
+		DeleteContext: userPhotoResourceDelete,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ParseUserPhotoID(id)
+			return err
+		}),
+
+		Schema: map[string]*pluginsdk.Schema{
+			"user_object_id": {
+				Description:  "The object ID of the user for which to set the profile photo",
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"photo": {
+				Description:      "Base64 encoded profile photo in jpeg or png format",
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ValidateDiagFunc: usersValidate.ProfilePhoto,
+			},
+
+			"content_type": {
+				Description:  "The content type of the profile photo. Detected from the image data when not specified",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(usersValidate.ProfilePhotoContentTypes, false),
+			},
+
+			"etag": {
+				Description: "The media ETag of the profile photo, which changes whenever the photo is replaced",
+				Type:        pluginsdk.TypeString,
+				Computed:    true,
+			},
+
+			"height": {
+				Description: "The height of the profile photo, in pixels",
+				Type:        pluginsdk.TypeInt,
+				Computed:    true,
+			},
+
+			"width": {
+				Description: "The width of the profile photo, in pixels",
+				Type:        pluginsdk.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func userPhotoResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserPhotoClient
+	userClient := meta.(*clients.Client).Users.UserClient
+
+	resourceId := parse.NewUserPhotoID(d.Get("user_object_id").(string))
+	userId := stable.NewUserID(resourceId.UserId)
+
+	if resp, err := userClient.GetUser(ctx, userId, user.GetUserOperationOptions{Select: &[]string{"id"}}); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagPathF(nil, "user_object_id", "%s was not found", userId)
+		}
+		return tf.ErrorDiagPathF(err, "user_object_id", "Retrieving %s", userId)
+	}
+
+	if diags := userPhotoUpload(ctx, d, client, userId); diags != nil {
+		return diags
+	}
+
+	d.SetId(resourceId.ID())
+
+	return userPhotoResourceRead(ctx, d, meta)
+}
+
+func userPhotoResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserPhotoClient
+
+	resourceId, err := parse.ParseUserPhotoID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User Photo ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	if d.HasChanges("photo", "content_type") {
+		if diags := userPhotoUpload(ctx, d, client, userId); diags != nil {
+			return diags
+		}
+	}
+
+	return userPhotoResourceRead(ctx, d, meta)
+}
+
+func userPhotoResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserPhotoClient
+
+	resourceId, err := parse.ParseUserPhotoID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User Photo ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	resp, err := client.GetPhoto(ctx, userId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] Profile photo for %s was not found - removing from state", userId)
+			d.SetId("")
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Retrieving profile photo for %s", userId)
+	}
+	if resp.Model == nil {
+		return tf.ErrorDiagF(errors.New("model was nil"), "Retrieving profile photo for %s", userId)
+	}
+
+	// The photo is not returned by the API, so a changed ETag indicates that it was replaced outside of Terraform, in
+	// which case the configured photo is uploaded again on the next apply
+	etag := pointer.From(resp.Model.MediaEtag)
+	if previousEtag := d.Get("etag").(string); previousEtag != "" && etag != previousEtag {
+		log.Printf("[DEBUG] Profile photo for %s was changed outside of Terraform (ETag %q, expected %q)", userId, etag, previousEtag)
+		tf.Set(d, "photo", "")
+	}
+
+	tf.Set(d, "user_object_id", resourceId.UserId)
+	tf.Set(d, "etag", etag)
+	tf.Set(d, "height", pointer.From(resp.Model.Height))
+	tf.Set(d, "width", pointer.From(resp.Model.Width))
+
+	return nil
+}
+
+func userPhotoResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
+	client := meta.(*clients.Client).Users.UserPhotoClient
+
+	resourceId, err := parse.ParseUserPhotoID(d.Id())
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "id", "Parsing User Photo ID %q", d.Id())
+	}
+	userId := stable.NewUserID(resourceId.UserId)
+
+	if resp, err := client.DeletePhotoValue(ctx, userId); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] Profile photo for %s was already deleted", userId)
+			return nil
+		}
+		return tf.ErrorDiagF(err, "Deleting profile photo for %s", userId)
+	}
+
+	if err := consistency.WaitForDeletion(ctx, func(ctx context.Context) (*bool, error) {
+		if resp, err := client.GetPhoto(ctx, userId); err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		return pointer.To(true), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for deletion of profile photo for %s", userId)
+	}
+
+	return nil
+}
+
+// userPhotoUpload decodes the configured photo and uploads it, waiting for the ETag of the photo to change so that the new
+// photo is available
+func userPhotoUpload(ctx context.Context, d *pluginsdk.ResourceData, client *userphoto.UserPhotoClient, userId stable.UserId) pluginsdk.Diagnostics {
+	contentType, imageData, err := userParsePhoto(d.Get("photo").(string), d.Get("content_type").(string))
+	if err != nil {
+		return tf.ErrorDiagPathF(err, "photo", "Invalid profile photo")
+	}
+
+	// The user may already have a photo which was not set by Terraform, so retrieve the current ETag
+	previousEtag := ""
+	resp, err := client.GetPhoto(ctx, userId)
+	if err != nil {
+		if !response.WasNotFound(resp.HttpResponse) {
+			return tf.ErrorDiagF(err, "Retrieving profile photo for %s", userId)
+		}
+	} else if resp.Model != nil {
+		previousEtag = pointer.From(resp.Model.MediaEtag)
+	}
+
+	if _, err = client.SetPhotoValue(ctx, userId, imageData, contentType); err != nil {
+		return tf.ErrorDiagF(err, "Could not upload profile photo for %s", userId)
+	}
+
+	if err = consistency.WaitForUpdate(ctx, func(ctx context.Context) (*bool, error) {
+		resp, err := client.GetPhoto(ctx, userId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, err
+		}
+		if resp.Model == nil {
+			return pointer.To(false), nil
+		}
+		etag := pointer.From(resp.Model.MediaEtag)
+		return pointer.To(etag != "" && etag != previousEtag), nil
+	}); err != nil {
+		return tf.ErrorDiagF(err, "Waiting for profile photo upload for %s", userId)
+	}
+
+	// Record the ETag of the uploaded photo, so that it's not mistaken for a photo changed outside of Terraform
+	resp, err = client.GetPhoto(ctx, userId)
+	if err != nil {
+		return tf.ErrorDiagF(err, "Retrieving profile photo for %s", userId)
+	}
+	if resp.Model != nil {
+		tf.Set(d, "etag", pointer.From(resp.Model.MediaEtag))
+	}
+
+	return nil
+}
+
+// userParsePhoto decodes a base64 encoded profile photo and returns its content type, which is detected from the image
+// data when not specified
+func userParsePhoto(encodedImage, contentType string) (string, []byte, error) {
+	imageData, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedImage))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(imageData) > usersValidate.ProfilePhotoMaxSize {
+		return "", nil, fmt.Errorf("profile photo must not be larger than %d bytes", usersValidate.ProfilePhotoMaxSize)
+	}
+
+	if contentType == "" {
+		contentType = http.DetectContentType(imageData)
+	}
+	for _, v := range usersValidate.ProfilePhotoContentTypes {
+		if contentType == v {
+			return contentType, imageData, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("unsupported content type %q, expected one of: %s", contentType, strings.Join(usersValidate.ProfilePhotoContentTypes, ", "))
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	usersValidate "github.com/hashicorp/terraform-provider-azuread/internal/services/users/validate"
+)
+
+func TestUserParsePhoto(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	jpeg := []byte("\xff\xd8\xff\xe0\x00\x10JFIF\x00")
+	gif := []byte("GIF89a\x01\x00\x01\x00")
+
+	testCases := []struct {
+		name        string
+		input       string
+		contentType string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "detect png",
+			input:    base64.StdEncoding.EncodeToString(png),
+			expected: "image/png",
+		},
+		{
+			name:     "detect jpeg",
+			input:    base64.StdEncoding.EncodeToString(jpeg),
+			expected: "image/jpeg",
+		},
+		{
+			name:        "explicit content type",
+			input:       base64.StdEncoding.EncodeToString(png),
+			contentType: "image/jpeg",
+			expected:    "image/jpeg",
+		},
+		{
+			name:        "unsupported format",
+			input:       base64.StdEncoding.EncodeToString(gif),
+			expectError: true,
+		},
+		{
+			name:        "invalid base64",
+			input:       "not base64!",
+			expectError: true,
+		},
+		{
+			name:        "too large",
+			input:       base64.StdEncoding.EncodeToString(append(png, []byte(strings.Repeat("0", usersValidate.ProfilePhotoMaxSize))...)),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			contentType, data, err := userParsePhoto(tc.input, tc.contentType)
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if contentType != tc.expected {
+				t.Fatalf("expected content type %q, got %q", tc.expected, contentType)
+			}
+			if len(data) == 0 {
+				t.Fatalf("expected image data, got none")
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azuread/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azuread/internal/clients"
+	"github.com/hashicorp/terraform-provider-azuread/internal/services/users/parse"
+)
+
+// 48x48 solid colour PNG images
+const (
+	testUserPhotoBlue   = "iVBORw0KGgoAAAANSUhEUgAAADAAAAAwCAIAAADYYG7QAAAAOElEQVR42u3OMQ0AAAgDsKlFLaLmgnA0qYAms78ICQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJCd0psNLClwpSHQwAAAAASUVORK5CYII="
+	testUserPhotoOrange = "iVBORw0KGgoAAAANSUhEUgAAADAAAAAwCAIAAADYYG7QAAAAOklEQVR42u3OMQ0AAAgDsHnCvwNEzQXhaFIBzU5eiZCQkJCQkJCQkJCQkJCQkJCQkJCQkJCQkJDQnQIUBPd5RHHYrgAAAABJRU5ErkJggg=="
+)
+
+type UserPhotoResource struct{}
+
+func TestAccUserPhoto_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_photo", "test")
+	r := UserPhotoResource{}
+
+	userId := os.Getenv("ARM_TEST_PHOTO_USER_OBJECT_ID")
+	if userId == "" {
+		t.Skip("ARM_TEST_PHOTO_USER_OBJECT_ID must be set to the object ID of a user with an Exchange Online mailbox in the test tenant")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(userId, testUserPhotoBlue),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("etag").Exists(),
+				check.That(data.ResourceName).Key("height").HasValue("48"),
+				check.That(data.ResourceName).Key("width").HasValue("48"),
+			),
+		},
+		data.ImportStep("photo"),
+	})
+}
+
+func TestAccUserPhoto_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user_photo", "test")
+	r := UserPhotoResource{}
+
+	userId := os.Getenv("ARM_TEST_PHOTO_USER_OBJECT_ID")
+	if userId == "" {
+		t.Skip("ARM_TEST_PHOTO_USER_OBJECT_ID must be set to the object ID of a user with an Exchange Online mailbox in the test tenant")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(userId, testUserPhotoBlue),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("photo"),
+		{
+			Config: r.basic(userId, testUserPhotoOrange),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("etag").Exists(),
+			),
+		},
+		data.ImportStep("photo"),
+	})
+}
+
+func (r UserPhotoResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	client := clients.Users.UserPhotoClient
+
+	id, err := parse.ParseUserPhotoID(state.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing User Photo ID: %v", err)
+	}
+
+	resp, err := client.GetPhoto(ctx, stable.NewUserID(id.UserId))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (UserPhotoResource) basic(userId, photo string) string {
+	return fmt.Sprintf(`
+provider "azuread" {}
+
+resource "azuread_user_photo" "test" {
+  user_object_id = "%[1]s"
+  photo          = "%[2]s"
+}
+`, userId, photo)
+}
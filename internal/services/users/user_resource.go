@@ -154,6 +154,22 @@ func userResource() *pluginsdk.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 16),
 			},
 
+			"employee_hire_date": {
+				Description:      "The date and time when the user was hired or will start work, formatted as an RFC3339 date string",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: userDiffSuppressTime,
+			},
+
+			"employee_leave_date_time": {
+				Description:      "The date and time when the user left or will leave the organization, formatted as an RFC3339 date string",
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: userDiffSuppressTime,
+			},
+
 			"employee_type": {
 				Description:  "Captures enterprise worker type. For example, Employee, Contractor, Consultant, or Vendor.",
 				Type:         pluginsdk.TypeString,
@@ -201,9 +217,10 @@ func userResource() *pluginsdk.Resource {
 			},
 
 			"manager_id": {
-				Description: "The object ID of the user's manager",
-				Type:        pluginsdk.TypeString,
-				Optional:    true,
+				Description:  "The object ID of the user's manager",
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
 			},
 
 			"mobile_phone": {
@@ -395,9 +412,37 @@ func userResourceCustomizeDiff(_ context.Context, diff *pluginsdk.ResourceDiff,
 		return fmt.Errorf("`consent_provided_for_minor` can only be set to %q or %q when `age_group` is %q or %q",
 			ConsentProvidedForMinorGranted, ConsentProvidedForMinorDenied, AgeGroupAdult, AgeGroupNotAdult)
 	}
+
+	if hireDate, leaveDate := diff.Get("employee_hire_date").(string), diff.Get("employee_leave_date_time").(string); hireDate != "" && leaveDate != "" {
+		hire, err := time.Parse(time.RFC3339, hireDate)
+		if err != nil {
+			return fmt.Errorf("parsing `employee_hire_date`: %+v", err)
+		}
+		leave, err := time.Parse(time.RFC3339, leaveDate)
+		if err != nil {
+			return fmt.Errorf("parsing `employee_leave_date_time`: %+v", err)
+		}
+		if !leave.After(hire) {
+			return fmt.Errorf("`employee_leave_date_time` must be later than `employee_hire_date`")
+		}
+	}
+
 	return nil
 }
 
+// userDiffSuppressTime suppresses differences between equivalent RFC3339 timestamps, since the API normalizes them to UTC
+func userDiffSuppressTime(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	o, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	n, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return o.Equal(n)
+}
+
 func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) pluginsdk.Diagnostics {
 	client := meta.(*clients.Client).Users.UserClient
 	clientBeta := meta.(*clients.Client).Users.UserClientBeta
@@ -438,6 +483,7 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		Country:                 nullable.NoZero(d.Get("country").(string)),
 		Department:              nullable.NoZero(d.Get("department").(string)),
 		DisplayName:             nullable.NoZero(d.Get("display_name").(string)),
+		EmployeeHireDate:        nullable.NoZero(d.Get("employee_hire_date").(string)),
 		EmployeeId:              nullable.NoZero(d.Get("employee_id").(string)),
 		EmployeeOrgData: &stable.EmployeeOrgData{
 			CostCenter: nullable.NoZero(d.Get("cost_center").(string)),
@@ -475,6 +521,11 @@ func userResourceCreate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		properties.OnPremisesImmutableId = nullable.NoZero(v.(string))
 	}
 
+	// Setting employeeLeaveDateTime requires additional permissions, so only send it when specified
+	if v, ok := d.GetOk("employee_leave_date_time"); ok {
+		properties.EmployeeLeaveDateTime = nullable.NoZero(v.(string))
+	}
+
 	options := user.CreateUserOperationOptions{
 		RetryFunc: func(resp *http.Response, o *odata.OData) (bool, error) {
 			if response.WasBadRequest(resp) && o != nil && o.Error != nil {
@@ -546,6 +597,9 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
 	}
 
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
 	var passwordPolicies []string
 	if d.Get("disable_strong_password").(bool) {
 		passwordPolicies = append(passwordPolicies, "DisableStrongPassword")
@@ -563,6 +617,7 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		Country:                 nullable.NoZero(d.Get("country").(string)),
 		Department:              nullable.NoZero(d.Get("department").(string)),
 		DisplayName:             nullable.Value(d.Get("display_name").(string)),
+		EmployeeHireDate:        nullable.NoZero(d.Get("employee_hire_date").(string)),
 		EmployeeId:              nullable.NoZero(d.Get("employee_id").(string)),
 		EmployeeOrgData: &stable.EmployeeOrgData{
 			CostCenter: nullable.NoZero(d.Get("cost_center").(string)),
@@ -593,6 +648,10 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("employee_leave_date_time") {
+		properties.EmployeeLeaveDateTime = nullable.NoZero(d.Get("employee_leave_date_time").(string))
+	}
+
 	if d.HasChange("business_phones") {
 		properties.BusinessPhones = tf.ExpandStringSlicePtr(d.Get("business_phones").([]interface{}))
 	}
@@ -640,7 +699,8 @@ func userResourceUpdate(ctx context.Context, d *pluginsdk.ResourceData, meta int
 				return tf.ErrorDiagPathF(err, "manager_id", "Could not assign manager for %s", id)
 			}
 		} else {
-			if _, err = managerClient.RemoveManagerRef(ctx, *id, manager.DefaultRemoveManagerRefOperationOptions()); err != nil {
+			// A 404 indicates the user no longer has a manager, e.g. it was removed outside of Terraform
+			if resp, err := managerClient.RemoveManagerRef(ctx, *id, manager.DefaultRemoveManagerRefOperationOptions()); err != nil && !response.WasNotFound(resp.HttpResponse) {
 				return tf.ErrorDiagPathF(err, "manager_id", "Could not remove manager for %s", id)
 			}
 		}
@@ -707,6 +767,7 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 			"consentProvidedForMinor",
 			"country",
 			"department",
+			"employeeHireDate",
 			"employeeId",
 			"employeeOrgData",
 			"employeeType",
@@ -738,6 +799,7 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 	tf.Set(d, "consent_provided_for_minor", uExtra.ConsentProvidedForMinor.GetOrZero())
 	tf.Set(d, "country", uExtra.Country.GetOrZero())
 	tf.Set(d, "department", uExtra.Department.GetOrZero())
+	tf.Set(d, "employee_hire_date", uExtra.EmployeeHireDate.GetOrZero())
 	tf.Set(d, "employee_id", uExtra.EmployeeId.GetOrZero())
 	tf.Set(d, "employee_type", uExtra.EmployeeType.GetOrZero())
 	tf.Set(d, "external_user_state", uExtra.ExternalUserState.GetOrZero())
@@ -755,6 +817,18 @@ func userResourceRead(ctx context.Context, d *pluginsdk.ResourceData, meta inter
 		tf.Set(d, "division", orgData.Division.GetOrZero())
 	}
 
+	// Reading employeeLeaveDateTime requires the User-LifeCycleInfo.Read.All permission, so it's retrieved separately and
+	// only when the property is managed, to avoid an additional request and permission error for every other user
+	if d.Get("employee_leave_date_time").(string) != "" {
+		leaveResp, err := client.GetUser(ctx, *id, user.GetUserOperationOptions{Select: &[]string{"employeeLeaveDateTime"}})
+		if err != nil {
+			return tf.ErrorDiagF(err, "Retrieving `employeeLeaveDateTime` for %s", id)
+		}
+		if leaveResp.Model != nil {
+			tf.Set(d, "employee_leave_date_time", leaveResp.Model.EmployeeLeaveDateTime.GetOrZero())
+		}
+	}
+
 	disableStrongPassword := false
 	disablePasswordExpiration := false
 
@@ -814,6 +888,9 @@ func userResourceDelete(ctx context.Context, d *pluginsdk.ResourceData, meta int
 		return tf.ErrorDiagPathF(err, "id", "Parsing ID")
 	}
 
+	tf.LockByName(userResourceName, id.UserId)
+	defer tf.UnlockByName(userResourceName, id.UserId)
+
 	if _, err = client.DeleteUser(ctx, *id, user.DefaultDeleteUserOperationOptions()); err != nil {
 		return tf.ErrorDiagPathF(err, "id", "Deleting %s", id)
 	}
@@ -67,6 +67,8 @@ func TestAccUser_update(t *testing.T) {
 			Config: r.complete(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("manager_id").MatchesOtherKey(check.That("azuread_user.manager").Key("object_id")),
+				check.That(data.ResourceName).Key("employee_hire_date").HasValue("2020-01-01T08:00:00Z"),
 			),
 		},
 		data.ImportStep("force_password_change", "password"),
@@ -74,6 +76,8 @@ func TestAccUser_update(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("manager_id").IsEmpty(),
+				check.That(data.ResourceName).Key("employee_hire_date").IsEmpty(),
 			),
 		},
 		data.ImportStep("force_password_change", "password"),
@@ -156,6 +160,18 @@ func TestAccUser_passwordInvalid(t *testing.T) {
 	})
 }
 
+func TestAccUser_employeeLeaveBeforeHire(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azuread_user", "test")
+	r := UserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.employeeLeaveBeforeHire(data),
+			ExpectError: regexp.MustCompile("`employee_leave_date_time` must be later than `employee_hire_date`"),
+		},
+	})
+}
+
 func (r UserResource) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
 	client := clients.Users.UserClient
 
@@ -228,6 +244,7 @@ resource "azuread_user" "test" {
   department                 = "acctestUser-%[1]d-Dept"
   display_name               = "acctestUser-%[1]d-DisplayName"
   division                   = "acctestUser-%[1]d-Division"
+  employee_hire_date         = "2020-01-01T09:00:00+01:00"
   employee_id                = "%[3]s%[3]s"
   employee_type              = "Contractor"
   fax_number                 = "(555) 555-5555"
@@ -332,3 +349,19 @@ resource "azuread_user" "test" {
 }
 `, data.RandomInteger, password)
 }
+
+func (UserResource) employeeLeaveBeforeHire(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+data "azuread_domains" "test" {
+  only_initial = true
+}
+
+resource "azuread_user" "test" {
+  user_principal_name      = "acctestUser'%[1]d@${data.azuread_domains.test.domains.0.domain_name}"
+  display_name             = "acctestUser-%[1]d"
+  password                 = "%[2]s"
+  employee_hire_date       = "2024-06-01T00:00:00Z"
+  employee_leave_date_time = "2024-01-01T00:00:00Z"
+}
+`, data.RandomInteger, data.RandomPassword)
+}
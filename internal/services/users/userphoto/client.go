@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package userphoto provides a client for the user profile photo API, which is not yet available in the
+// microsoft-graph SDK (only the size-specific photos collection is).
+package userphoto
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/msgraph"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type UserPhotoClient struct {
+	Client *msgraph.Client
+}
+
+func NewUserPhotoClientWithBaseURI(sdkApi sdkEnv.Api) (*UserPhotoClient, error) {
+	client, err := msgraph.NewClient(sdkApi, "userphoto", msgraph.VersionOnePointZero)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating UserPhotoClient: %+v", err)
+	}
+
+	return &UserPhotoClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package userphoto
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/microsoft-graph/common-types/stable"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+// ProfilePhoto describes the metadata for a user's profile photo
+type ProfilePhoto struct {
+	Height    *int64  `json:"height,omitempty"`
+	Width     *int64  `json:"width,omitempty"`
+	MediaEtag *string `json:"@odata.mediaEtag,omitempty"`
+}
+
+type OperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type GetPhotoOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ProfilePhoto
+}
+
+type operationOptions struct{}
+
+func (o operationOptions) ToHeaders() *client.Headers {
+	return &client.Headers{}
+}
+
+func (o operationOptions) ToOData() *odata.Query {
+	return &odata.Query{}
+}
+
+func (o operationOptions) ToQuery() *client.QueryParams {
+	return &client.QueryParams{}
+}
+
+// GetPhoto - Get profilePhoto. Retrieve the metadata for the specified user's profile photo.
+func (c UserPhotoClient) GetPhoto(ctx context.Context, id stable.UserId) (result GetPhotoOperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodGet, fmt.Sprintf("%s/photo", id.ID()), "application/json; charset=utf-8", []int{http.StatusOK}, nil)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model ProfilePhoto
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// SetPhotoValue - Update profilePhoto. Upload the specified user's profile photo, with the provided image content type.
+func (c UserPhotoClient) SetPhotoValue(ctx context.Context, id stable.UserId, input []byte, contentType string) (result OperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodPut, fmt.Sprintf("%s/photo/$value", id.ID()), contentType, []int{http.StatusAccepted, http.StatusCreated, http.StatusNoContent, http.StatusOK}, input)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+// DeletePhotoValue - Delete profilePhoto. Remove the specified user's profile photo.
+func (c UserPhotoClient) DeletePhotoValue(ctx context.Context, id stable.UserId) (result OperationResponse, err error) {
+	resp, err := c.execute(ctx, http.MethodDelete, fmt.Sprintf("%s/photo/$value", id.ID()), "application/json; charset=utf-8", []int{http.StatusNoContent, http.StatusOK}, nil)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+
+	return
+}
+
+func (c UserPhotoClient) execute(ctx context.Context, method, path, contentType string, expectedStatusCodes []int, input []byte) (*client.Response, error) {
+	opts := client.RequestOptions{
+		ContentType:         contentType,
+		ExpectedStatusCodes: expectedStatusCodes,
+		HttpMethod:          method,
+		OptionsObject:       operationOptions{},
+		Path:                path,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if input != nil {
+		if err = req.Marshal(input); err != nil {
+			return nil, err
+		}
+	}
+
+	return req.Execute(ctx)
+}
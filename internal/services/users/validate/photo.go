@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azuread/internal/helpers/tf/pluginsdk"
+)
+
+// ProfilePhotoMaxSize is the maximum size in bytes of a user profile photo accepted by Microsoft Graph
+const ProfilePhotoMaxSize = 4 * 1024 * 1024
+
+var ProfilePhotoContentTypes = []string{"image/jpeg", "image/png"}
+
+// ProfilePhoto checks whether a value is a base64 encoded jpeg or png image, which is no larger than the maximum size
+// supported for user profile photos.
+func ProfilePhoto(i interface{}, path cty.Path) (ret pluginsdk.Diagnostics) {
+	v, ok := i.(string)
+	if !ok {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Expected a string value",
+			AttributePath: path,
+		})
+		return
+	}
+
+	imageData, err := base64.StdEncoding.DecodeString(strings.TrimSpace(v))
+	if err != nil {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Value must be a base64 encoded image",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+		return
+	}
+
+	if contentType := http.DetectContentType(imageData); !slices.Contains(ProfilePhotoContentTypes, contentType) {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Profile photo must be in jpeg or png format",
+			Detail:        fmt.Sprintf("The detected content type was %q", contentType),
+			AttributePath: path,
+		})
+	}
+
+	if len(imageData) > ProfilePhotoMaxSize {
+		ret = append(ret, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Profile photo must be no larger than %d MB", ProfilePhotoMaxSize/1024/1024),
+			Detail:        fmt.Sprintf("The decoded image is %d bytes", len(imageData)),
+			AttributePath: path,
+		})
+	}
+
+	return // nolint:nakedret
+}
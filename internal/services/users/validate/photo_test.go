@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestProfilePhoto(t *testing.T) {
+	pngHeader := []byte("\x89PNG\x0D\x0A\x1A\x0A")
+	jpegHeader := []byte("\xFF\xD8\xFF\xE0")
+	gifHeader := []byte("GIF89a")
+
+	cases := []struct {
+		Value    string
+		TestName string
+		ErrCount int
+	}{
+		{
+			Value:    base64.StdEncoding.EncodeToString(pngHeader),
+			TestName: "Valid_Png",
+			ErrCount: 0,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString(jpegHeader),
+			TestName: "Valid_Jpeg",
+			ErrCount: 0,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString(append(pngHeader, make([]byte, ProfilePhotoMaxSize-len(pngHeader))...)),
+			TestName: "Valid_MaxSize",
+			ErrCount: 0,
+		},
+		{
+			Value:    "not base64!",
+			TestName: "Invalid_NotBase64",
+			ErrCount: 1,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString(gifHeader),
+			TestName: "Invalid_Gif",
+			ErrCount: 1,
+		},
+		{
+			Value:    base64.StdEncoding.EncodeToString(append(pngHeader, bytes.Repeat([]byte{0}, ProfilePhotoMaxSize)...)),
+			TestName: "Invalid_TooLarge",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.TestName, func(t *testing.T) {
+			diags := ProfilePhoto(tc.Value, cty.Path{})
+
+			if len(diags) != tc.ErrCount {
+				t.Fatalf("Expected ProfilePhoto to have %d not %d errors for %q", tc.ErrCount, len(diags), tc.TestName)
+			}
+		})
+	}
+}